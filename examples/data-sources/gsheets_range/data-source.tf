@@ -9,3 +9,11 @@ data "gsheets_range" "test" {
 output "rows" {
   value = gsheets_range.test.values
 }
+
+data "gsheets_range" "tagged" {
+  spreadsheet_id = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  data_filter = {
+    metadata_key   = "owner"
+    metadata_value = "terraform"
+  }
+}
@@ -0,0 +1,9 @@
+data "gsheets_sensitive_range" "api_keys" {
+  spreadsheet_id = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  range          = "'keys'!A2:B10"
+}
+
+output "stripe_key" {
+  value     = data.gsheets_sensitive_range.api_keys.values[0][1]
+  sensitive = true
+}
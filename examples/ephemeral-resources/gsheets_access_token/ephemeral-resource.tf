@@ -0,0 +1,6 @@
+ephemeral "gsheets_access_token" "deploy" {
+  scopes = [
+    "https://www.googleapis.com/auth/spreadsheets",
+    "https://www.googleapis.com/auth/script.projects",
+  ]
+}
@@ -0,0 +1,8 @@
+ephemeral "gsheets_range" "api_keys" {
+  spreadsheet_id = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  range          = "'keys'!A2:B10"
+}
+
+provider "github" {
+  token = ephemeral.gsheets_range.api_keys.values[0][1]
+}
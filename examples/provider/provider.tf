@@ -6,3 +6,33 @@ variable "service_account_credentials" {
 provider "gsheets" {
   service_account_key = var.service_account_credentials
 }
+
+variable "user_credentials" {
+  description = "authorized_user json created by gcloud auth application-default login"
+  type        = string
+  sensitive   = true
+}
+
+provider "gsheets" {
+  alias               = "user"
+  oauth_client_id     = jsondecode(var.user_credentials).client_id
+  oauth_client_secret = jsondecode(var.user_credentials).client_secret
+  refresh_token       = jsondecode(var.user_credentials).refresh_token
+}
+
+variable "access_token" {
+  description = "access token obtained with workload identity federation, GSHEETS_ACCESS_TOKEN can be used instead"
+  type        = string
+  sensitive   = true
+}
+
+provider "gsheets" {
+  alias        = "ci"
+  access_token = var.access_token
+}
+
+provider "gsheets" {
+  alias               = "app_files"
+  service_account_key = var.service_account_credentials
+  scopes              = ["https://www.googleapis.com/auth/drive.file"]
+}
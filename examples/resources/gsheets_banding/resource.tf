@@ -0,0 +1,9 @@
+resource "gsheets_banding" "table" {
+  spreadsheet_id = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  range          = "'test title'!A1:D20"
+  row_properties = {
+    header_color      = "#356854"
+    first_band_color  = "#FFFFFF"
+    second_band_color = "#E6EFEB"
+  }
+}
@@ -0,0 +1,58 @@
+resource "gsheets_cells" "legend" {
+  spreadsheet_id = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  anchor_cell    = "'Legend'!B2"
+  rows = [
+    {
+      cells = [
+        {
+          user_entered_value = { string_value = "Status: see the runbook" }
+          text_format_runs = [
+            { start_index = 0, format = { bold = true } },
+            { start_index = 8, link = "https://example.com/runbook" },
+          ]
+        },
+      ]
+    },
+  ]
+}
+
+resource "gsheets_cells" "budget" {
+  spreadsheet_id = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  anchor_cell    = "'Budget'!A1"
+  rows = [
+    {
+      cells = [
+        {
+          user_entered_value  = { string_value = "Amount" }
+          user_entered_format = { text_format = { bold = true }, background_color = "#eeeeee" }
+        },
+        {
+          user_entered_value  = { string_value = "Approved" }
+          user_entered_format = { text_format = { bold = true }, background_color = "#eeeeee" }
+        },
+      ]
+    },
+    {
+      cells = [
+        {
+          user_entered_value  = { number_value = 1250.5 }
+          user_entered_format = { number_format = { type = "CURRENCY" } }
+          note                = "In euros"
+        },
+        {
+          user_entered_value = { bool_value = false }
+          data_validation    = { condition = { type = "BOOLEAN" } }
+        },
+      ]
+    },
+    {
+      cells = [
+        { user_entered_value = { formula_value = "=SUM(A2:A2)" } },
+        {
+          user_entered_value = { string_value = "Policy" }
+          hyperlink          = "https://example.com/policy"
+        },
+      ]
+    },
+  ]
+}
@@ -0,0 +1,33 @@
+resource "gsheets_chart" "weekly_access" {
+  spreadsheet_id = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  title          = "Weekly access"
+  basic_chart = {
+    chart_type      = "LINE"
+    legend_position = "BOTTOM_LEGEND"
+    header_count    = 1
+    domain          = "'access report'!A1:A53"
+    series = [{
+      range = "'access report'!B1:B53"
+      color = "#356854"
+    }]
+    bottom_axis_title = "Week"
+  }
+  position = {
+    overlay = {
+      anchor_cell = "'access report'!E2"
+    }
+  }
+}
+
+resource "gsheets_chart" "by_team" {
+  spreadsheet_id = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  title          = "Access by team"
+  pie_chart = {
+    domain   = "'access report'!G1:G8"
+    series   = "'access report'!H1:H8"
+    pie_hole = 0.4
+  }
+  position = {
+    new_sheet = true
+  }
+}
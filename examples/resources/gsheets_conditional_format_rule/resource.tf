@@ -0,0 +1,31 @@
+resource "gsheets_conditional_format_rule" "expired" {
+  spreadsheet_id = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  ranges         = ["'roster'!A2:C"]
+  boolean_rule = {
+    condition = {
+      type   = "CUSTOM_FORMULA"
+      values = ["=$C2<TODAY()"]
+    }
+    format = {
+      background_color = "#F4CCCC"
+      text_format = {
+        foreground_color = "#990000"
+      }
+    }
+  }
+}
+
+resource "gsheets_conditional_format_rule" "score" {
+  spreadsheet_id = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  ranges         = ["'roster'!D2:D"]
+  gradient_rule = {
+    minpoint = {
+      color = "#FFFFFF"
+      type  = "MIN"
+    }
+    maxpoint = {
+      color = "#57BB8A"
+      type  = "MAX"
+    }
+  }
+}
@@ -0,0 +1,18 @@
+resource "gsheets_data_validation" "role" {
+  spreadsheet_id = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  range          = "'roster'!C2:C"
+  condition = {
+    type   = "ONE_OF_LIST"
+    values = ["admin", "member", "viewer"]
+  }
+  strict         = true
+  show_custom_ui = true
+}
+
+resource "gsheets_data_validation" "approved" {
+  spreadsheet_id = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  range          = "'roster'!D2:D"
+  condition = {
+    type = "BOOLEAN"
+  }
+}
@@ -0,0 +1,24 @@
+resource "gsheets_developer_metadata" "service_accounts" {
+  spreadsheet_id = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  key            = "owner"
+  value          = "terraform"
+  location = {
+    dimension_range = {
+      sheet_id    = 0
+      dimension   = "ROWS"
+      start_index = 20
+      end_index   = 25
+    }
+  }
+}
+
+resource "gsheets_range" "service_accounts" {
+  spreadsheet_id = gsheets_developer_metadata.service_accounts.spreadsheet_id
+  data_filter = {
+    metadata_id = gsheets_developer_metadata.service_accounts.metadata_id
+  }
+  values = [
+    ["svc-deploy", "admin"],
+    ["svc-backup", "reader"],
+  ]
+}
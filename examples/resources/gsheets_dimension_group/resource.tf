@@ -0,0 +1,8 @@
+resource "gsheets_dimension_group" "details" {
+  spreadsheet_id = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  sheet_id       = 0
+  dimension      = "ROWS"
+  start_index    = 2
+  end_index      = 8
+  collapsed      = true
+}
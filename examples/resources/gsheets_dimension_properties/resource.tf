@@ -0,0 +1,33 @@
+resource "gsheets_sheet" "report" {
+  spreadsheet_id = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  properties = {
+    title = "report"
+  }
+}
+
+resource "gsheets_dimension_properties" "names" {
+  spreadsheet_id = gsheets_sheet.report.spreadsheet_id
+  sheet_id       = gsheets_sheet.report.properties.sheet_id
+  dimension      = "COLUMNS"
+  start_index    = 0
+  end_index      = 3
+  pixel_size     = 200
+}
+
+resource "gsheets_dimension_properties" "notes" {
+  spreadsheet_id = gsheets_sheet.report.spreadsheet_id
+  sheet_id       = gsheets_sheet.report.properties.sheet_id
+  dimension      = "COLUMNS"
+  start_index    = 3
+  end_index      = 4
+  auto_resize    = true
+}
+
+resource "gsheets_dimension_properties" "raw_header" {
+  spreadsheet_id = gsheets_sheet.report.spreadsheet_id
+  sheet_id       = gsheets_sheet.report.properties.sheet_id
+  dimension      = "ROWS"
+  start_index    = 0
+  end_index      = 1
+  hidden_by_user = true
+}
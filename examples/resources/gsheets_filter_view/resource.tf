@@ -0,0 +1,24 @@
+resource "gsheets_filter_view" "team_a" {
+  spreadsheet_id = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  title          = "Team A"
+  range          = "'roster'!A1:F"
+
+  sort_specs = [{
+    column_index = 1
+    sort_order   = "ASCENDING"
+  }]
+
+  filter_specs = [
+    {
+      column_index = 2
+      condition = {
+        type   = "TEXT_EQ"
+        values = ["Team A"]
+      }
+    },
+    {
+      column_index  = 5
+      hidden_values = ["inactive"]
+    },
+  ]
+}
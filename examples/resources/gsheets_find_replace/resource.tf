@@ -0,0 +1,17 @@
+resource "gsheets_find_replace" "rename_team" {
+  spreadsheet_id = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  find           = "Platform"
+  replacement    = "Infrastructure"
+  match_case     = true
+}
+
+resource "gsheets_find_replace" "dates" {
+  spreadsheet_id  = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  range           = "'report'!A2:A"
+  find            = "^(\\d{2})/(\\d{2})/(\\d{4})$"
+  replacement     = "$3-$2-$1"
+  search_by_regex = true
+  triggers = {
+    revision = "2"
+  }
+}
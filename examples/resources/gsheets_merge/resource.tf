@@ -0,0 +1,16 @@
+resource "gsheets_merge" "title" {
+  spreadsheet_id = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  range          = "'report'!A1:D1"
+}
+
+resource "gsheets_merge" "groups" {
+  spreadsheet_id = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  grid_range = {
+    sheet_id           = 0
+    start_row_index    = 1
+    end_row_index      = 10
+    start_column_index = 0
+    end_column_index   = 2
+  }
+  merge_type = "MERGE_ROWS"
+}
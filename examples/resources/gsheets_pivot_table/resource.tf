@@ -0,0 +1,21 @@
+resource "gsheets_pivot_table" "by_team" {
+  spreadsheet_id = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  anchor_cell    = "'summary'!A1"
+  source         = "'roster'!A1:D200"
+  rows = [{
+    source_column_offset = 0
+    label                = "Team"
+  }]
+  columns = [{
+    source_column_offset = 1
+  }]
+  values = [{
+    source_column_offset = 2
+    summarize_function   = "COUNTA"
+    name                 = "People"
+  }]
+  filter_specs = [{
+    source_column_offset = 3
+    visible_values       = ["active"]
+  }]
+}
@@ -0,0 +1,17 @@
+resource "gsheets_range" "test_range" {
+  spreadsheet_id = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  range          = "'test title'!A:C"
+  values = [
+    ["a", "b", "c"],
+    [1, 2, 3],
+  ]
+}
+
+resource "gsheets_protected_range" "test_range" {
+  spreadsheet_id = gsheets_range.test_range.spreadsheet_id
+  range          = gsheets_range.test_range.range
+  description    = "Managed by terraform"
+  editors = {
+    users = ["admin@example.com"]
+  }
+}
@@ -13,3 +13,58 @@ resource "gsheets_range" "test_range" {
     [1, 2, 3],
   ]
 }
+
+resource "gsheets_range" "protected_range" {
+  spreadsheet_id = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  range          = provider::gsheets::format_range(gsheets_sheet.test, "E:F")
+  values = [
+    ["owner", "email"],
+  ]
+  protect = {
+    description = "Managed by terraform"
+    editors = {
+      users = ["admin@example.com"]
+    }
+  }
+}
+
+resource "gsheets_range" "members" {
+  spreadsheet_id = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  range          = provider::gsheets::format_range(gsheets_sheet.test, "H2:I")
+  insert_mode    = "shift"
+  values = [
+    ["alice", "admin"],
+    ["bob", "reader"],
+  ]
+}
+
+resource "gsheets_range" "roster" {
+  spreadsheet_id = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  range          = provider::gsheets::format_range(gsheets_sheet.test, "K:L")
+  values = [
+    ["alice", "alice@example.com"],
+    ["bob", "bob@example.com"],
+  ]
+  notes = [
+    ["approved by carol on 2024-05-02"],
+  ]
+  hyperlinks = [
+    ["", "mailto:alice@example.com"],
+    ["", "mailto:bob@example.com"],
+  ]
+}
+
+resource "gsheets_range" "leaderboard" {
+  spreadsheet_id = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  range          = provider::gsheets::format_range(gsheets_sheet.test, "N2:O")
+  values = [
+    ["carol", "42"],
+    ["alice", "17"],
+  ]
+  sort_specs = [
+    {
+      column_index = 14
+      sort_order   = "DESCENDING"
+    },
+  ]
+}
@@ -0,0 +1,28 @@
+resource "gsheets_range_format" "header" {
+  spreadsheet_id = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  range          = "Sheet1!A1:D1"
+
+  text_format = {
+    bold             = true
+    foreground_color = "#FFFFFF"
+  }
+  background_color     = "#1A73E8"
+  horizontal_alignment = "CENTER"
+
+  borders = {
+    bottom = {
+      style = "SOLID_MEDIUM"
+    }
+  }
+}
+
+resource "gsheets_range_format" "amounts" {
+  spreadsheet_id = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  range          = "Sheet1!D2:D"
+
+  number_format = {
+    type    = "CURRENCY"
+    pattern = "#,##0.00 €"
+  }
+  wrap_strategy = "CLIP"
+}
@@ -6,3 +6,17 @@ resource "gsheets_sheet" "test" {
     title = "test title"
   }
 }
+
+resource "gsheets_sheet" "roster" {
+  spreadsheet_id = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  properties = {
+    title = "roster"
+  }
+  basic_filter = {
+    range = "A1:F"
+    sort_specs = [{
+      column_index = 0
+      sort_order   = "ASCENDING"
+    }]
+  }
+}
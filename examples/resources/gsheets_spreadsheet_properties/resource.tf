@@ -0,0 +1,20 @@
+resource "gsheets_spreadsheet_properties" "budget" {
+  spreadsheet_id = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  title          = "Budget"
+  locale         = "en_GB"
+  time_zone      = "Europe/London"
+  auto_recalc    = "ON_CHANGE"
+  default_format = {
+    text_format = {
+      font_family = "Roboto"
+      font_size   = 10
+    }
+  }
+  spreadsheet_theme = {
+    primary_font_family = "Roboto"
+    theme_colors = {
+      ACCENT1 = "#1a73e8"
+      LINK    = "#1155cc"
+    }
+  }
+}
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &AccessTokenEphemeralResource{}
+
+// DefaultAccessTokenScopes are the scopes of the access tokens when neither the token nor the provider configure them.
+var DefaultAccessTokenScopes = []string{ScopeSpreadsheets}
+
+func NewAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &AccessTokenEphemeralResource{}
+}
+
+type AccessTokenEphemeralResource struct {
+	data *EphemeralResourceData
+}
+
+type AccessTokenEphemeralResourceModel struct {
+	Scopes      []types.String `tfsdk:"scopes"`
+	AccessToken types.String   `tfsdk:"access_token"`
+	ExpiresAt   types.String   `tfsdk:"expires_at"`
+}
+
+func (r *AccessTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_token"
+}
+
+func (r *AccessTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Mints a short-lived OAuth access token with the credentials of the provider.
+
+The token is never stored in the state or the plan. It requires Terraform 1.10 or later, and the provider must be configured with a service account key or user credentials.
+Tokens of a service account are valid for one hour, google doesn't issue shorter ones.
+With user credentials, the token has the scopes the user consented to, and scopes are ignored. With an access token, that same token is returned.`,
+
+		Attributes: map[string]schema.Attribute{
+			"scopes": schema.ListAttribute{
+				MarkdownDescription: "The OAuth scopes of the token. Defaults to the scopes of the provider, or `https://www.googleapis.com/auth/spreadsheets`",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"access_token": schema.StringAttribute{
+				MarkdownDescription: "The access token",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "When the token expires in RFC 3339 format",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure implements ephemeral.EphemeralResourceWithConfigure.
+func (r *AccessTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*EphemeralResourceData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Type",
+			fmt.Sprintf("Expected *EphemeralResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.data = data
+}
+
+// Open is called when the provider must generate a new ephemeral resource. Config
+// values should be read from the OpenRequest and new result values set on the OpenResponse.
+func (r *AccessTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data AccessTokenEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.data == nil {
+		resp.Diagnostics.AddError("Unconfigured provider", "The provider was not configured before opening the access token. Please report this issue to the provider developers.")
+		return
+	}
+
+	if r.data.TokenSource == nil {
+		resp.Diagnostics.AddError("Missing credentials", "The provider must be configured with an access_token, a service_account_key or a refresh_token to mint access tokens.")
+		return
+	}
+
+	var scopes []string
+	for _, scope := range data.Scopes {
+		scopes = append(scopes, scope.ValueString())
+	}
+
+	token, err := r.data.TokenSource(ctx, scopes).Token()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to mint access token", err.Error())
+		return
+	}
+
+	data.AccessToken = types.StringValue(token.AccessToken)
+	data.ExpiresAt = types.StringNull()
+	if !token.Expiry.IsZero() {
+		data.ExpiresAt = types.StringValue(token.Expiry.UTC().Format(time.RFC3339))
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
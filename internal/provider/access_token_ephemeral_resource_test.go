@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// testServiceAccountKey returns a service account key, in the format of the provider, that mints tokens from the url.
+func testServiceAccountKey(t *testing.T, tokenURL string, scopes []string) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	credentials, err := json.Marshal(CredentialsFile{
+		Email:        "terraform@example.iam.gserviceaccount.com",
+		PrivateKeyID: "test-key-id",
+		PrivateKey:   string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})),
+		TokenURL:     tokenURL,
+		Scopes:       scopes,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(credentials)
+}
+
+func TestAccAccessTokenEphemeralResource(t *testing.T) {
+	// The claims of the last assertion sent to the token endpoint.
+	claims := map[string]interface{}{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		err := r.ParseForm()
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		payload, err := base64.RawURLEncoding.DecodeString(strings.Split(r.PostForm.Get("assertion"), ".")[1])
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		claims = map[string]interface{}{}
+		err = json.Unmarshal(payload, &claims)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "ya29.test-token",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+		})
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	key := testServiceAccountKey(t, server.URL+"/token", []string{ScopeSpreadsheets})
+
+	config := func(attributes string) string {
+		return fmt.Sprintf(`
+provider "gsheets" {
+	service_account_key = %q
+}
+
+ephemeral "gsheets_access_token" "test" {
+	%s
+}
+
+provider "echo" {
+	data = ephemeral.gsheets_access_token.test
+}
+
+resource "echo" "test" {}
+`, key, attributes)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"gsheets": testAccProtoV6ProviderFactories["gsheets"],
+			"echo":    echoprovider.NewProviderServer(),
+		},
+		IsUnitTest: true,
+		Steps: []resource.TestStep{
+			{
+				Config: config(""),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("access_token"), knownvalue.StringExact("ya29.test-token")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("expires_at"), knownvalue.StringRegexp(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T`))),
+				},
+				Check: func(s *terraform.State) error {
+					if claims["scope"] != "https://www.googleapis.com/auth/spreadsheets" {
+						return fmt.Errorf("Unexpected scope %v", claims["scope"])
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccAccessTokenEphemeralResource_Scopes(t *testing.T) {
+	scopes := ""
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		err := r.ParseForm()
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		payload, err := base64.RawURLEncoding.DecodeString(strings.Split(r.PostForm.Get("assertion"), ".")[1])
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		claims := struct {
+			Scope string `json:"scope"`
+		}{}
+		err = json.Unmarshal(payload, &claims)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		scopes = claims.Scope
+
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(map[string]interface{}{"access_token": "ya29.test-token", "token_type": "Bearer"})
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"gsheets": testAccProtoV6ProviderFactories["gsheets"],
+			"echo":    echoprovider.NewProviderServer(),
+		},
+		IsUnitTest: true,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	service_account_key = %q
+}
+
+ephemeral "gsheets_access_token" "test" {
+	scopes = ["https://www.googleapis.com/auth/spreadsheets.readonly", "https://www.googleapis.com/auth/drive.file"]
+}
+
+provider "echo" {
+	data = ephemeral.gsheets_access_token.test
+}
+
+resource "echo" "test" {}
+`, testServiceAccountKey(t, server.URL+"/token", nil)),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("expires_at"), knownvalue.Null()),
+				},
+				Check: func(s *terraform.State) error {
+					if scopes != "https://www.googleapis.com/auth/spreadsheets.readonly https://www.googleapis.com/auth/drive.file" {
+						return fmt.Errorf("Unexpected scopes %s", scopes)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
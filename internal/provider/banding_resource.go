@@ -0,0 +1,375 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"google.golang.org/api/sheets/v4"
+)
+
+var _ resource.ResourceWithConfigure = &BandingResource{}
+var _ resource.ResourceWithImportState = &BandingResource{}
+
+func NewBandingResource() resource.Resource {
+	return &BandingResource{}
+}
+
+type BandingResource struct {
+	client *sheets.Service
+}
+
+type BandingResourceModel struct {
+	SpreadsheetID    types.String            `tfsdk:"spreadsheet_id"`
+	BandedRangeID    types.Int64             `tfsdk:"banded_range_id"`
+	Range            types.String            `tfsdk:"range"`
+	RowProperties    *BandingPropertiesModel `tfsdk:"row_properties"`
+	ColumnProperties *BandingPropertiesModel `tfsdk:"column_properties"`
+}
+
+type BandingPropertiesModel struct {
+	HeaderColor     types.String `tfsdk:"header_color"`
+	FirstBandColor  types.String `tfsdk:"first_band_color"`
+	SecondBandColor types.String `tfsdk:"second_band_color"`
+	FooterColor     types.String `tfsdk:"footer_color"`
+}
+
+// ToBandingProperties converts the model into the API representation. It returns nil when there is no model.
+func (m *BandingPropertiesModel) ToBandingProperties() *sheets.BandingProperties {
+	if m == nil {
+		return nil
+	}
+	return &sheets.BandingProperties{
+		HeaderColor:     ColorFromValue(m.HeaderColor),
+		FirstBandColor:  ColorFromValue(m.FirstBandColor),
+		SecondBandColor: ColorFromValue(m.SecondBandColor),
+		FooterColor:     ColorFromValue(m.FooterColor),
+	}
+}
+
+// Refresh returns the model updated with the properties returned by the API. It returns nil when there are no properties.
+func (m *BandingPropertiesModel) Refresh(properties *sheets.BandingProperties) *BandingPropertiesModel {
+	if properties == nil {
+		return nil
+	}
+	if m == nil {
+		m = &BandingPropertiesModel{}
+	}
+	m.HeaderColor = RefreshColor(m.HeaderColor, properties.HeaderColor)
+	m.FirstBandColor = RefreshColor(m.FirstBandColor, properties.FirstBandColor)
+	m.SecondBandColor = RefreshColor(m.SecondBandColor, properties.SecondBandColor)
+	m.FooterColor = RefreshColor(m.FooterColor, properties.FooterColor)
+	return m
+}
+
+func (m BandingResourceModel) ToBandedRange(spreadsheet *sheets.Spreadsheet) (*sheets.BandedRange, error) {
+	gridRange, err := GridRangeFromA1(spreadsheet, m.Range.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	return &sheets.BandedRange{
+		BandedRangeId:    m.BandedRangeID.ValueInt64(),
+		Range:            gridRange,
+		RowProperties:    m.RowProperties.ToBandingProperties(),
+		ColumnProperties: m.ColumnProperties.ToBandingProperties(),
+	}, nil
+}
+
+// Refresh updates the model with the banded range returned by the API.
+func (m *BandingResourceModel) Refresh(spreadsheet *sheets.Spreadsheet, bandedRange *sheets.BandedRange) error {
+	var err error
+	m.BandedRangeID = types.Int64Value(bandedRange.BandedRangeId)
+	m.Range, err = RefreshA1(spreadsheet, m.Range, bandedRange.Range)
+	if err != nil {
+		return err
+	}
+	m.RowProperties = m.RowProperties.Refresh(bandedRange.RowProperties)
+	m.ColumnProperties = m.ColumnProperties.Refresh(bandedRange.ColumnProperties)
+	return nil
+}
+
+// FindBandedRange returns the banded range with the given id. It returns nil if it doesn't exist.
+func FindBandedRange(spreadsheet *sheets.Spreadsheet, id int64) *sheets.BandedRange {
+	for _, sheet := range spreadsheet.Sheets {
+		for _, bandedRange := range sheet.BandedRanges {
+			if bandedRange.BandedRangeId == id {
+				return bandedRange
+			}
+		}
+	}
+	return nil
+}
+
+func (r *BandingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_banding"
+}
+
+func (r *BandingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	color := func(description string, required bool) schema.StringAttribute {
+		return schema.StringAttribute{
+			MarkdownDescription: description,
+			Required:            required,
+			Optional:            !required,
+			Validators: []validator.String{
+				hexColorValidator(),
+			},
+		}
+	}
+	properties := func(description string, other string) schema.SingleNestedAttribute {
+		return schema.SingleNestedAttribute{
+			MarkdownDescription: description,
+			Optional:            true,
+			Validators: []validator.Object{
+				objectvalidator.AtLeastOneOf(path.MatchRoot(other)),
+			},
+			Attributes: map[string]schema.Attribute{
+				"header_color":      color("The color of the first row or column in #RRGGBB notation. If not set, the first band color is used", false),
+				"first_band_color":  color("The first alternating color in #RRGGBB notation", true),
+				"second_band_color": color("The second alternating color in #RRGGBB notation", true),
+				"footer_color":      color("The color of the last row or column in #RRGGBB notation. If not set, the last row or column keeps its band color", false),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Alternates the colors of the rows or columns of a range, like the tables created from the google sheets UI.
+
+A range can only have one banding.`,
+
+		Attributes: map[string]schema.Attribute{
+			"spreadsheet_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID for the spreadsheet. It can be obtained from the URL.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"banded_range_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the banded range",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"range": schema.StringAttribute{
+				MarkdownDescription: "The range to band in A1 notation. Use the sheet title to point to a specific sheet.",
+				Required:            true,
+			},
+			"row_properties":    properties("Alternates the colors of the rows", "column_properties"),
+			"column_properties": properties("Alternates the colors of the columns. Row colors take precedence when both are set.", "row_properties"),
+		},
+	}
+}
+
+// Configure implements resource.ResourceWithConfigure.
+func (r *BandingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sheets.Service)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Type",
+			fmt.Sprintf("Expected *sheets.Service, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create is called when the provider must create a new resource. Config
+// and planned state values should be read from the
+// CreateRequest and new state values set on the CreateResponse.
+func (r *BandingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BandingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spreadsheet, err := r.getSpreadsheet(ctx, data.SpreadsheetID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read data,", err.Error())
+		return
+	}
+
+	bandedRange, err := data.ToBandedRange(spreadsheet)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid range", err.Error())
+		return
+	}
+	bandedRange.BandedRangeId = 0
+
+	createRequest := r.client.Spreadsheets.BatchUpdate(data.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{AddBanding: &sheets.AddBandingRequest{
+				BandedRange: bandedRange,
+			}},
+		},
+	})
+	createRequest.Context(ctx)
+	createResponse, err := createRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create banding", err.Error())
+		return
+	}
+
+	data.BandedRangeID = types.Int64Value(createResponse.Replies[0].AddBanding.BandedRange.BandedRangeId)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (r *BandingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ":", 2)
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError("ID is not correct", "The ID must be a <spreadsheet_id>:<banded_range_id>, but it was "+req.ID)
+		return
+	}
+
+	id, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("ID is not correct", "The banded range id must be a number, but it was "+parts[1])
+		return
+	}
+
+	data := BandingResourceModel{
+		SpreadsheetID: basetypes.NewStringValue(parts[0]),
+		BandedRangeID: basetypes.NewInt64Value(id),
+		Range:         basetypes.NewStringNull(),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read is called when the provider must read resource values in order
+// to update state. Planned state values should be read from the
+// ReadRequest and new state values set on the ReadResponse.
+func (r *BandingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BandingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spreadsheet, err := r.getSpreadsheet(ctx, data.SpreadsheetID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read data,", err.Error())
+		return
+	}
+
+	bandedRange := FindBandedRange(spreadsheet, data.BandedRangeID.ValueInt64())
+	if bandedRange == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	err = data.Refresh(spreadsheet, bandedRange)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read banding", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is called to update the state of the resource. Config, planned
+// state, and prior state values should be read from the
+// UpdateRequest and new state values set on the UpdateResponse.
+func (r *BandingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BandingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spreadsheet, err := r.getSpreadsheet(ctx, data.SpreadsheetID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read data,", err.Error())
+		return
+	}
+
+	bandedRange, err := data.ToBandedRange(spreadsheet)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid range", err.Error())
+		return
+	}
+
+	updateRequest := r.client.Spreadsheets.BatchUpdate(data.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{UpdateBanding: &sheets.UpdateBandingRequest{
+				BandedRange: bandedRange,
+				Fields:      "range,rowProperties,columnProperties",
+			}},
+		},
+	})
+	updateRequest.Context(ctx)
+	_, err = updateRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to perform update request", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete is called when the provider must delete the resource. Config
+// values may be read from the DeleteRequest.
+//
+// If execution completes without error, the framework will automatically
+// call DeleteResponse.State.RemoveResource(), so it can be omitted
+// from provider logic.
+func (r *BandingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BandingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteRequest := r.client.Spreadsheets.BatchUpdate(data.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{DeleteBanding: &sheets.DeleteBandingRequest{
+				BandedRangeId: data.BandedRangeID.ValueInt64(),
+			}},
+		},
+	})
+	deleteRequest.Context(ctx)
+	_, err := deleteRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to delete banding", err.Error())
+		return
+	}
+}
+
+func (r *BandingResource) getSpreadsheet(ctx context.Context, spreadsheetID string) (*sheets.Spreadsheet, error) {
+	getRequest := r.client.Spreadsheets.Get(spreadsheetID)
+	getRequest.Fields("spreadsheetId,sheets(properties,bandedRanges)")
+	getRequest.Context(ctx)
+	return getRequest.Do()
+}
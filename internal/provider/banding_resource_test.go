@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestAccBandingResource(t *testing.T) {
+	var bandedRanges []*sheets.BandedRange
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v4/spreadsheets/{spreadsheetId}", func(w http.ResponseWriter, r *http.Request) {
+		res := sheets.Spreadsheet{
+			SpreadsheetId: r.PathValue("spreadsheetId"),
+			Sheets: []*sheets.Sheet{
+				{
+					Properties:   &sheets.SheetProperties{SheetId: 2, Title: "test title"},
+					BandedRanges: bandedRanges,
+				},
+			},
+		}
+		err := json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+	mux.HandleFunc("POST /v4/spreadsheets/{spreadsheetIdUpdate}", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		requestBody := &sheets.BatchUpdateSpreadsheetRequest{}
+		err := json.NewDecoder(r.Body).Decode(requestBody)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		res := sheets.BatchUpdateSpreadsheetResponse{
+			SpreadsheetId: strings.Split(r.PathValue("spreadsheetIdUpdate"), ":")[0],
+			Replies:       []*sheets.Response{{}},
+		}
+		request := requestBody.Requests[0]
+		switch {
+		case request.AddBanding != nil:
+			bandedRange := request.AddBanding.BandedRange
+			bandedRange.BandedRangeId = 12
+			bandedRanges = []*sheets.BandedRange{bandedRange}
+			res.Replies[0].AddBanding = &sheets.AddBandingResponse{BandedRange: bandedRange}
+		case request.UpdateBanding != nil:
+			if request.UpdateBanding.Fields != "range,rowProperties,columnProperties" {
+				t.Errorf("Unexpected fields %s", request.UpdateBanding.Fields)
+			}
+			bandedRanges = []*sheets.BandedRange{request.UpdateBanding.BandedRange}
+		case request.DeleteBanding != nil:
+			bandedRanges = nil
+		default:
+			t.Errorf("Unexpected request %v", request)
+		}
+
+		err = json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_banding" "table" {
+	spreadsheet_id = "test-spreadsheet-id"
+	range = "'test title'!A1:D20"
+	row_properties = {
+		header_color = "#356854"
+		first_band_color = "#ffffff"
+		second_band_color = "#E6EFEB"
+	}
+}
+	`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_banding.table", "banded_range_id", "12"),
+					resource.TestCheckResourceAttr("gsheets_banding.table", "row_properties.first_band_color", "#ffffff"),
+					resource.TestCheckNoResourceAttr("gsheets_banding.table", "row_properties.footer_color"),
+					resource.TestCheckNoResourceAttr("gsheets_banding.table", "column_properties"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_banding" "table" {
+	spreadsheet_id = "test-spreadsheet-id"
+	range = "'test title'!A1:D30"
+	row_properties = {
+		header_color = "#356854"
+		first_band_color = "#FFFFFF"
+		second_band_color = "#E6EFEB"
+		footer_color = "#BDBDBD"
+	}
+}
+	`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_banding.table", "banded_range_id", "12"),
+					resource.TestCheckResourceAttr("gsheets_banding.table", "range", "'test title'!A1:D30"),
+					resource.TestCheckResourceAttr("gsheets_banding.table", "row_properties.footer_color", "#BDBDBD"),
+				),
+			},
+			{
+				ResourceName:                         "gsheets_banding.table",
+				ImportState:                          true,
+				ImportStateId:                        "test-spreadsheet-id:12",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "banded_range_id",
+			},
+		},
+	})
+}
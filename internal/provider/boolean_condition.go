@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/api/sheets/v4"
+)
+
+// BooleanConditionTypes are the condition types supported by google sheets.
+// Not all of them are valid everywhere, google sheets will reject the ones that don't apply.
+var BooleanConditionTypes = []string{
+	"NUMBER_GREATER", "NUMBER_GREATER_THAN_EQ", "NUMBER_LESS", "NUMBER_LESS_THAN_EQ", "NUMBER_EQ", "NUMBER_NOT_EQ", "NUMBER_BETWEEN", "NUMBER_NOT_BETWEEN",
+	"TEXT_CONTAINS", "TEXT_NOT_CONTAINS", "TEXT_STARTS_WITH", "TEXT_ENDS_WITH", "TEXT_EQ", "TEXT_NOT_EQ", "TEXT_IS_EMAIL", "TEXT_IS_URL",
+	"DATE_EQ", "DATE_NOT_EQ", "DATE_BEFORE", "DATE_AFTER", "DATE_ON_OR_BEFORE", "DATE_ON_OR_AFTER", "DATE_BETWEEN", "DATE_NOT_BETWEEN", "DATE_IS_VALID",
+	"ONE_OF_RANGE", "ONE_OF_LIST", "BLANK", "NOT_BLANK", "CUSTOM_FORMULA", "BOOLEAN", "FILTER_EXPRESSION",
+}
+
+type BooleanConditionModel struct {
+	Type         types.String   `tfsdk:"type"`
+	Values       []types.String `tfsdk:"values"`
+	RelativeDate types.String   `tfsdk:"relative_date"`
+}
+
+// ToBooleanCondition converts the model into the API representation. It returns nil when there is no model.
+func (m *BooleanConditionModel) ToBooleanCondition() *sheets.BooleanCondition {
+	if m == nil {
+		return nil
+	}
+
+	condition := &sheets.BooleanCondition{
+		Type: m.Type.ValueString(),
+	}
+	for _, value := range m.Values {
+		condition.Values = append(condition.Values, &sheets.ConditionValue{
+			UserEnteredValue: value.ValueString(),
+		})
+	}
+	if !m.RelativeDate.IsNull() {
+		condition.Values = append(condition.Values, &sheets.ConditionValue{
+			RelativeDate: m.RelativeDate.ValueString(),
+		})
+	}
+	return condition
+}
+
+// NewBooleanConditionModel is the inverse of ToBooleanCondition. It returns nil when there is no condition.
+func NewBooleanConditionModel(condition *sheets.BooleanCondition) *BooleanConditionModel {
+	if condition == nil {
+		return nil
+	}
+
+	m := &BooleanConditionModel{
+		Type:         types.StringValue(condition.Type),
+		RelativeDate: types.StringNull(),
+	}
+	for _, value := range condition.Values {
+		if value.RelativeDate != "" {
+			m.RelativeDate = types.StringValue(value.RelativeDate)
+			continue
+		}
+		m.Values = append(m.Values, types.StringValue(value.UserEnteredValue))
+	}
+	return m
+}
+
+// Equal compares the conditions ignoring the difference between null and empty values.
+func (m *BooleanConditionModel) Equal(other *BooleanConditionModel) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if !m.Type.Equal(other.Type) || !m.RelativeDate.Equal(other.RelativeDate) || len(m.Values) != len(other.Values) {
+		return false
+	}
+	for i := range m.Values {
+		if !m.Values[i].Equal(other.Values[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// booleanConditionAttribute is shared by all the resources that evaluate conditions over cells.
+func booleanConditionAttribute(description string, required bool) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Required:            required,
+		Optional:            !required,
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of condition, such as `ONE_OF_LIST`, `NUMBER_BETWEEN` or `CUSTOM_FORMULA`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(BooleanConditionTypes...),
+				},
+			},
+			"values": schema.ListAttribute{
+				MarkdownDescription: "The values of the condition. The number of values depends on the type. Formulas and ranges must start with `=`",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"relative_date": schema.StringAttribute{
+				MarkdownDescription: "A date relative to the current date for date conditions, such as `TODAY` or `PAST_WEEK`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("PAST_YEAR", "PAST_MONTH", "PAST_WEEK", "YESTERDAY", "TODAY", "TOMORROW"),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestBooleanConditionRoundTrip(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition *sheets.BooleanCondition
+	}{
+		{
+			name:      "No condition",
+			condition: nil,
+		},
+		{
+			name:      "Without values",
+			condition: &sheets.BooleanCondition{Type: "BOOLEAN"},
+		},
+		{
+			name: "With values",
+			condition: &sheets.BooleanCondition{
+				Type: "NUMBER_BETWEEN",
+				Values: []*sheets.ConditionValue{
+					{UserEnteredValue: "1"},
+					{UserEnteredValue: "10"},
+				},
+			},
+		},
+		{
+			name: "Relative date",
+			condition: &sheets.BooleanCondition{
+				Type: "DATE_AFTER",
+				Values: []*sheets.ConditionValue{
+					{RelativeDate: "TODAY"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := NewBooleanConditionModel(tt.condition)
+			result := model.ToBooleanCondition()
+			if !reflect.DeepEqual(result, tt.condition) {
+				t.Errorf("Expected %+v, got %+v", tt.condition, result)
+			}
+			if !model.Equal(NewBooleanConditionModel(result)) {
+				t.Errorf("Expected models to be equal")
+			}
+		})
+	}
+}
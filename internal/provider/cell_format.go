@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/api/sheets/v4"
+)
+
+// CellFormatModel is the format of a cell, except for the borders. Null attributes are not managed.
+type CellFormatModel struct {
+	NumberFormat        *NumberFormatModel `tfsdk:"number_format"`
+	TextFormat          *TextFormatModel   `tfsdk:"text_format"`
+	BackgroundColor     types.String       `tfsdk:"background_color"`
+	HorizontalAlignment types.String       `tfsdk:"horizontal_alignment"`
+	WrapStrategy        types.String       `tfsdk:"wrap_strategy"`
+}
+
+type NumberFormatModel struct {
+	Type    types.String `tfsdk:"type"`
+	Pattern types.String `tfsdk:"pattern"`
+}
+
+// ToCellFormat converts the managed attributes into the API representation.
+func (m *CellFormatModel) ToCellFormat() *sheets.CellFormat {
+	format := &sheets.CellFormat{
+		TextFormat:          m.TextFormat.ToTextFormat(),
+		BackgroundColor:     ColorFromValue(m.BackgroundColor),
+		HorizontalAlignment: m.HorizontalAlignment.ValueString(),
+		WrapStrategy:        m.WrapStrategy.ValueString(),
+	}
+	if m.NumberFormat != nil {
+		format.NumberFormat = &sheets.NumberFormat{
+			Type:    m.NumberFormat.Type.ValueString(),
+			Pattern: m.NumberFormat.Pattern.ValueString(),
+		}
+	}
+	return format
+}
+
+// Fields returns the field mask of the attributes that are set, prefixed by the path of the cell format.
+func (m *CellFormatModel) Fields(prefix string) []string {
+	if m == nil {
+		return nil
+	}
+	var fields []string
+	if m.NumberFormat != nil {
+		fields = append(fields, prefix+".numberFormat")
+	}
+	fields = append(fields, m.TextFormat.Fields(prefix+".textFormat")...)
+	if !m.BackgroundColor.IsNull() {
+		fields = append(fields, prefix+".backgroundColor")
+	}
+	if !m.HorizontalAlignment.IsNull() {
+		fields = append(fields, prefix+".horizontalAlignment")
+	}
+	if !m.WrapStrategy.IsNull() {
+		fields = append(fields, prefix+".wrapStrategy")
+	}
+	return fields
+}
+
+// Refresh updates the managed attributes with the formats of the cells.
+// When the cells don't share the same format, the first value that differs is kept so the drift shows up in the plan.
+func (m *CellFormatModel) Refresh(all []*sheets.CellFormat) {
+	if m.NumberFormat != nil {
+		m.NumberFormat.Type = refreshUniformString(m.NumberFormat.Type, all, func(f *sheets.CellFormat) string {
+			if f.NumberFormat == nil {
+				return ""
+			}
+			return f.NumberFormat.Type
+		})
+		m.NumberFormat.Pattern = refreshUniformString(m.NumberFormat.Pattern, all, func(f *sheets.CellFormat) string {
+			if f.NumberFormat == nil {
+				return ""
+			}
+			return f.NumberFormat.Pattern
+		})
+	}
+
+	if m.TextFormat != nil {
+		textFormat := func(f *sheets.CellFormat) *sheets.TextFormat {
+			if f.TextFormat == nil {
+				return &sheets.TextFormat{}
+			}
+			return f.TextFormat
+		}
+		m.TextFormat.Bold = refreshUniformBool(m.TextFormat.Bold, all, func(f *sheets.CellFormat) bool { return textFormat(f).Bold })
+		m.TextFormat.Italic = refreshUniformBool(m.TextFormat.Italic, all, func(f *sheets.CellFormat) bool { return textFormat(f).Italic })
+		m.TextFormat.Strikethrough = refreshUniformBool(m.TextFormat.Strikethrough, all, func(f *sheets.CellFormat) bool { return textFormat(f).Strikethrough })
+		m.TextFormat.Underline = refreshUniformBool(m.TextFormat.Underline, all, func(f *sheets.CellFormat) bool { return textFormat(f).Underline })
+		m.TextFormat.FontFamily = refreshUniformString(m.TextFormat.FontFamily, all, func(f *sheets.CellFormat) string { return textFormat(f).FontFamily })
+		if !m.TextFormat.FontSize.IsNull() {
+			for _, f := range all {
+				if size := textFormat(f).FontSize; size != m.TextFormat.FontSize.ValueInt64() {
+					m.TextFormat.FontSize = types.Int64Value(size)
+					break
+				}
+			}
+		}
+		m.TextFormat.ForegroundColor = refreshUniformColor(m.TextFormat.ForegroundColor, all, func(f *sheets.CellFormat) *sheets.Color { return textFormat(f).ForegroundColor })
+	}
+
+	m.BackgroundColor = refreshUniformColor(m.BackgroundColor, all, func(f *sheets.CellFormat) *sheets.Color { return f.BackgroundColor })
+	m.HorizontalAlignment = refreshUniformString(m.HorizontalAlignment, all, func(f *sheets.CellFormat) string { return f.HorizontalAlignment })
+	m.WrapStrategy = refreshUniformString(m.WrapStrategy, all, func(f *sheets.CellFormat) string { return f.WrapStrategy })
+}
+
+// cellFormatAttributes are shared by all the resources that format cells.
+func cellFormatAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"number_format": schema.SingleNestedAttribute{
+			MarkdownDescription: "How numbers are displayed",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					MarkdownDescription: "The type of the number format. One of `TEXT`, `NUMBER`, `PERCENT`, `CURRENCY`, `DATE`, `TIME`, `DATE_TIME` or `SCIENTIFIC`",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("TEXT", "NUMBER", "PERCENT", "CURRENCY", "DATE", "TIME", "DATE_TIME", "SCIENTIFIC"),
+					},
+				},
+				"pattern": schema.StringAttribute{
+					MarkdownDescription: "The pattern string, such as `#,##0.00` or `yyyy-mm-dd`. If not set, the default pattern of the locale is used.",
+					Optional:            true,
+				},
+			},
+		},
+		"text_format": textFormatAttribute("The text format of the cells"),
+		"background_color": schema.StringAttribute{
+			MarkdownDescription: "The background color in #RRGGBB notation",
+			Optional:            true,
+			Validators: []validator.String{
+				hexColorValidator(),
+			},
+		},
+		"horizontal_alignment": schema.StringAttribute{
+			MarkdownDescription: "The horizontal alignment of the value. One of `LEFT`, `CENTER` or `RIGHT`",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("LEFT", "CENTER", "RIGHT"),
+			},
+		},
+		"wrap_strategy": schema.StringAttribute{
+			MarkdownDescription: "How text that doesn't fit is displayed. One of `OVERFLOW_CELL`, `LEGACY_WRAP`, `CLIP` or `WRAP`",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("OVERFLOW_CELL", "LEGACY_WRAP", "CLIP", "WRAP"),
+			},
+		},
+	}
+}
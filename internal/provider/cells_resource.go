@@ -0,0 +1,580 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/sheets/v4"
+)
+
+var _ resource.ResourceWithConfigure = &CellsResource{}
+
+func NewCellsResource() resource.Resource {
+	return &CellsResource{}
+}
+
+type CellsResource struct {
+	client *sheets.Service
+}
+
+type CellsResourceModel struct {
+	SpreadsheetID types.String    `tfsdk:"spreadsheet_id"`
+	AnchorCell    types.String    `tfsdk:"anchor_cell"`
+	Rows          []CellsRowModel `tfsdk:"rows"`
+}
+
+type CellsRowModel struct {
+	Cells []CellModel `tfsdk:"cells"`
+}
+
+type CellModel struct {
+	UserEnteredValue  *ExtendedValueModel      `tfsdk:"user_entered_value"`
+	UserEnteredFormat *CellFormatModel         `tfsdk:"user_entered_format"`
+	Note              types.String             `tfsdk:"note"`
+	DataValidation    *CellDataValidationModel `tfsdk:"data_validation"`
+	Hyperlink         types.String             `tfsdk:"hyperlink"`
+	TextFormatRuns    []TextFormatRunModel     `tfsdk:"text_format_runs"`
+}
+
+// ExtendedValueModel is the value of a cell. Only one of the attributes is set.
+type ExtendedValueModel struct {
+	StringValue  types.String  `tfsdk:"string_value"`
+	NumberValue  types.Float64 `tfsdk:"number_value"`
+	BoolValue    types.Bool    `tfsdk:"bool_value"`
+	FormulaValue types.String  `tfsdk:"formula_value"`
+}
+
+func (m *ExtendedValueModel) ToExtendedValue() *sheets.ExtendedValue {
+	return &sheets.ExtendedValue{
+		StringValue:  m.StringValue.ValueStringPointer(),
+		NumberValue:  m.NumberValue.ValueFloat64Pointer(),
+		BoolValue:    m.BoolValue.ValueBoolPointer(),
+		FormulaValue: m.FormulaValue.ValueStringPointer(),
+	}
+}
+
+// NewExtendedValueModel is the inverse of ToExtendedValue. Every attribute is null when the cell is empty.
+func NewExtendedValueModel(value *sheets.ExtendedValue) *ExtendedValueModel {
+	if value == nil {
+		value = &sheets.ExtendedValue{}
+	}
+	return &ExtendedValueModel{
+		StringValue:  types.StringPointerValue(value.StringValue),
+		NumberValue:  types.Float64PointerValue(value.NumberValue),
+		BoolValue:    types.BoolPointerValue(value.BoolValue),
+		FormulaValue: types.StringPointerValue(value.FormulaValue),
+	}
+}
+
+// CellDataValidationModel is the data validation rule of a single cell. Null attributes are not managed.
+type CellDataValidationModel struct {
+	Condition    *BooleanConditionModel `tfsdk:"condition"`
+	Strict       types.Bool             `tfsdk:"strict"`
+	ShowCustomUI types.Bool             `tfsdk:"show_custom_ui"`
+	InputMessage types.String           `tfsdk:"input_message"`
+}
+
+func (m *CellDataValidationModel) ToDataValidationRule() *sheets.DataValidationRule {
+	return &sheets.DataValidationRule{
+		Condition:    m.Condition.ToBooleanCondition(),
+		Strict:       m.Strict.ValueBool(),
+		ShowCustomUi: m.ShowCustomUI.ValueBool(),
+		InputMessage: m.InputMessage.ValueString(),
+	}
+}
+
+// Refresh updates the model with the rule returned by the API.
+func (m *CellDataValidationModel) Refresh(rule *sheets.DataValidationRule) {
+	if condition := NewBooleanConditionModel(rule.Condition); !condition.Equal(m.Condition) {
+		m.Condition = condition
+	}
+	m.Strict = RefreshBool(m.Strict, rule.Strict)
+	m.ShowCustomUI = RefreshBool(m.ShowCustomUI, rule.ShowCustomUi)
+	m.InputMessage = RefreshString(m.InputMessage, rule.InputMessage)
+}
+
+// Fields returns the field mask of the attributes of the cell that are set.
+func (m CellModel) Fields() []string {
+	var fields []string
+	if m.UserEnteredValue != nil {
+		fields = append(fields, "userEnteredValue")
+	}
+	fields = append(fields, m.UserEnteredFormat.Fields("userEnteredFormat")...)
+	if !m.Note.IsNull() {
+		fields = append(fields, "note")
+	}
+	if m.DataValidation != nil {
+		fields = append(fields, "dataValidation")
+	}
+	// The hyperlink of a cell is read only, it is set through the link of the whole text.
+	if !m.Hyperlink.IsNull() {
+		fields = append(fields, "userEnteredFormat.textFormat.link")
+	}
+	if m.TextFormatRuns != nil {
+		fields = append(fields, "textFormatRuns")
+	}
+	return fields
+}
+
+// ToCellData converts the managed attributes of the cell into the API representation.
+func (m CellModel) ToCellData() *sheets.CellData {
+	cell := &sheets.CellData{
+		Note: m.Note.ValueString(),
+	}
+	if m.UserEnteredValue != nil {
+		cell.UserEnteredValue = m.UserEnteredValue.ToExtendedValue()
+	}
+	if m.UserEnteredFormat != nil {
+		cell.UserEnteredFormat = m.UserEnteredFormat.ToCellFormat()
+	}
+	if m.DataValidation != nil {
+		cell.DataValidation = m.DataValidation.ToDataValidationRule()
+	}
+	if !m.Hyperlink.IsNull() {
+		if cell.UserEnteredFormat == nil {
+			cell.UserEnteredFormat = &sheets.CellFormat{}
+		}
+		if cell.UserEnteredFormat.TextFormat == nil {
+			cell.UserEnteredFormat.TextFormat = &sheets.TextFormat{}
+		}
+		cell.UserEnteredFormat.TextFormat.Link = &sheets.Link{Uri: m.Hyperlink.ValueString()}
+	}
+	if m.TextFormatRuns != nil {
+		cell.TextFormatRuns = ToTextFormatRuns(m.TextFormatRuns)
+	}
+	return cell
+}
+
+// Refresh updates the managed attributes with the cell returned by the API.
+func (m *CellModel) Refresh(cell *sheets.CellData) {
+	if cell == nil {
+		cell = &sheets.CellData{}
+	}
+
+	format := cell.UserEnteredFormat
+	if format == nil {
+		format = &sheets.CellFormat{}
+	}
+
+	if m.UserEnteredValue != nil {
+		m.UserEnteredValue = NewExtendedValueModel(cell.UserEnteredValue)
+	}
+	if m.UserEnteredFormat != nil {
+		m.UserEnteredFormat.Refresh([]*sheets.CellFormat{format})
+	}
+	if !m.Note.IsNull() {
+		m.Note = types.StringValue(cell.Note)
+	}
+	if m.DataValidation != nil {
+		if cell.DataValidation == nil {
+			m.DataValidation = nil
+		} else {
+			m.DataValidation.Refresh(cell.DataValidation)
+		}
+	}
+	if !m.Hyperlink.IsNull() {
+		hyperlink := ""
+		if format.TextFormat != nil && format.TextFormat.Link != nil {
+			hyperlink = format.TextFormat.Link.Uri
+		}
+		m.Hyperlink = types.StringValue(hyperlink)
+	}
+
+	if m.TextFormatRuns != nil {
+		if runs := NewTextFormatRunModels(cell.TextFormatRuns); !EqualTextFormatRuns(m.TextFormatRuns, runs) {
+			m.TextFormatRuns = runs
+		}
+	}
+}
+
+// ToGridRange returns the rectangle covered by the rows, starting at the anchor cell.
+func (m CellsResourceModel) ToGridRange(spreadsheet *sheets.Spreadsheet) (*sheets.GridRange, error) {
+	anchor, err := GridRangeFromA1(spreadsheet, m.AnchorCell.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	columns := 0
+	for _, row := range m.Rows {
+		columns = max(columns, len(row.Cells))
+	}
+	return &sheets.GridRange{
+		SheetId:          anchor.SheetId,
+		StartRowIndex:    anchor.StartRowIndex,
+		EndRowIndex:      anchor.StartRowIndex + int64(max(len(m.Rows), 1)),
+		StartColumnIndex: anchor.StartColumnIndex,
+		EndColumnIndex:   anchor.StartColumnIndex + int64(max(columns, 1)),
+	}, nil
+}
+
+// Fields returns the field mask of the cell attributes that are set in any cell, so all of them are written with a single request.
+func (m CellsResourceModel) Fields() []string {
+	var fields []string
+	for _, row := range m.Rows {
+		for _, cell := range row.Cells {
+			fields = append(fields, cell.Fields()...)
+		}
+	}
+	return uniqueSorted(fields)
+}
+
+// BuildRequest returns the request that moves the cells from the previous model to this one.
+// Cells and attributes that are no longer managed are cleared, so a nil previous model writes the cells and
+// writing an empty model over the previous one clears them. It returns nil when there is nothing to write.
+func (m CellsResourceModel) BuildRequest(gridRange *sheets.GridRange, previous *CellsResourceModel) *sheets.Request {
+	fields := m.Fields()
+	var previousRows []CellsRowModel
+	if previous != nil {
+		fields = uniqueSorted(append(fields, previous.Fields()...))
+		previousRows = previous.Rows
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+
+	rows := []*sheets.RowData{}
+	for i := 0; i < max(len(m.Rows), len(previousRows)); i++ {
+		columns := 0
+		if i < len(m.Rows) {
+			columns = len(m.Rows[i].Cells)
+		}
+		if i < len(previousRows) {
+			columns = max(columns, len(previousRows[i].Cells))
+		}
+
+		row := &sheets.RowData{}
+		for j := 0; j < columns; j++ {
+			cell := &sheets.CellData{}
+			if i < len(m.Rows) && j < len(m.Rows[i].Cells) {
+				cell = m.Rows[i].Cells[j].ToCellData()
+			}
+			row.Values = append(row.Values, cell)
+		}
+		rows = append(rows, row)
+	}
+
+	return &sheets.Request{
+		UpdateCells: &sheets.UpdateCellsRequest{
+			Start: &sheets.GridCoordinate{
+				SheetId:         gridRange.SheetId,
+				RowIndex:        gridRange.StartRowIndex,
+				ColumnIndex:     gridRange.StartColumnIndex,
+				ForceSendFields: []string{"SheetId", "RowIndex", "ColumnIndex"},
+			},
+			Rows:   rows,
+			Fields: strings.Join(fields, ","),
+		},
+	}
+}
+
+// Refresh updates the managed attributes of every cell with the grid data of the sheet.
+func (m *CellsResourceModel) Refresh(sheet *sheets.Sheet, gridRange *sheets.GridRange) {
+	for i := range m.Rows {
+		for j := range m.Rows[i].Cells {
+			m.Rows[i].Cells[j].Refresh(CellAt(sheet, gridRange.StartRowIndex+int64(i), gridRange.StartColumnIndex+int64(j)))
+		}
+	}
+}
+
+func (r *CellsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cells"
+}
+
+func (r *CellsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages a block of cells starting at an anchor cell: their values, format, notes, data validation and links.
+
+The whole block is written with a single request, so values and formats are applied at once.
+An attribute set in any cell is managed in every cell of the block, so cells that don't set it are cleared.
+The cells are cleared when the resource is destroyed.`,
+
+		Attributes: map[string]schema.Attribute{
+			"spreadsheet_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID for the spreadsheet. It can be obtained from the URL.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"anchor_cell": schema.StringAttribute{
+				MarkdownDescription: "The top left cell of the block in A1 notation, like `'Legend'!B2`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rows": schema.ListNestedAttribute{
+				MarkdownDescription: "The rows of the block, starting at the anchor cell",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cells": schema.ListNestedAttribute{
+							MarkdownDescription: "The cells of the row, starting at the column of the anchor cell",
+							Required:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"user_entered_value": schema.SingleNestedAttribute{
+										MarkdownDescription: "The value of the cell as if a human typed it. Exactly one of the attributes must be set",
+										Optional:            true,
+										Attributes: map[string]schema.Attribute{
+											"string_value": schema.StringAttribute{
+												MarkdownDescription: "A text value. It is written as is, without parsing numbers or formulas",
+												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.ExactlyOneOf(
+														path.MatchRelative().AtParent().AtName("number_value"),
+														path.MatchRelative().AtParent().AtName("bool_value"),
+														path.MatchRelative().AtParent().AtName("formula_value"),
+													),
+												},
+											},
+											"number_value": schema.Float64Attribute{
+												MarkdownDescription: "A number. Dates and times are numbers formatted with `number_format`",
+												Optional:            true,
+											},
+											"bool_value": schema.BoolAttribute{
+												MarkdownDescription: "A boolean, displayed as a checkbox when the cell has a `BOOLEAN` data validation",
+												Optional:            true,
+											},
+											"formula_value": schema.StringAttribute{
+												MarkdownDescription: "A formula, starting with `=`",
+												Optional:            true,
+											},
+										},
+									},
+									"user_entered_format": schema.SingleNestedAttribute{
+										MarkdownDescription: "The format of the cell. Only the attributes that are set are managed",
+										Optional:            true,
+										Attributes:          cellFormatAttributes(),
+									},
+									"note": schema.StringAttribute{
+										MarkdownDescription: "The note of the cell",
+										Optional:            true,
+									},
+									"data_validation": schema.SingleNestedAttribute{
+										MarkdownDescription: "The data validation rule of the cell",
+										Optional:            true,
+										Attributes: map[string]schema.Attribute{
+											"condition": booleanConditionAttribute("The condition that data in the cell must match. Use `ONE_OF_LIST` for dropdowns and `BOOLEAN` for checkboxes.", true),
+											"strict": schema.BoolAttribute{
+												MarkdownDescription: "True if invalid data should be rejected",
+												Optional:            true,
+											},
+											"show_custom_ui": schema.BoolAttribute{
+												MarkdownDescription: "True if the UI should be customized based on the kind of condition. For example, dropdowns for `ONE_OF_LIST`",
+												Optional:            true,
+											},
+											"input_message": schema.StringAttribute{
+												MarkdownDescription: "A message to show the user when adding data to the cell",
+												Optional:            true,
+											},
+										},
+									},
+									"hyperlink": schema.StringAttribute{
+										MarkdownDescription: "The URL the whole text of the cell links to",
+										Optional:            true,
+									},
+									"text_format_runs": textFormatRunsAttribute("The format of parts of the text of the cell. Each run applies until the start of the next one"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure implements resource.ResourceWithConfigure.
+func (r *CellsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sheets.Service)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Type",
+			fmt.Sprintf("Expected *sheets.Service, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create is called when the provider must create a new resource. Config
+// and planned state values should be read from the
+// CreateRequest and new state values set on the CreateResponse.
+func (r *CellsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CellsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.write(ctx, data, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to write cells", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read is called when the provider must read resource values in order
+// to update state. Planned state values should be read from the
+// ReadRequest and new state values set on the ReadResponse.
+func (r *CellsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CellsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fields := data.Fields()
+	if len(fields) == 0 {
+		return
+	}
+
+	getRequest := r.client.Spreadsheets.Get(data.SpreadsheetID.ValueString())
+	getRequest.Fields("spreadsheetId,sheets.properties")
+	getRequest.Context(ctx)
+	spreadsheet, err := getRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read data,", err.Error())
+		return
+	}
+
+	gridRange, err := data.ToGridRange(spreadsheet)
+	var sheetNotFound *SheetNotFoundError
+	if errors.As(err, &sheetNotFound) {
+		// The cells were deleted along with the sheet.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid anchor cell", err.Error())
+		return
+	}
+	a1, err := A1FromGridRange(spreadsheet, gridRange)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid anchor cell", err.Error())
+		return
+	}
+
+	getRequest = r.client.Spreadsheets.Get(data.SpreadsheetID.ValueString())
+	getRequest.Ranges(a1)
+	getRequest.IncludeGridData(true)
+	getRequest.Fields(googleapi.Field("spreadsheetId,sheets(properties,data(startRow,startColumn,rowData.values(" + strings.Join(fields, ",") + ")))"))
+	getRequest.Context(ctx)
+	spreadsheet, err = getRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read data,", err.Error())
+		return
+	}
+
+	sheet := FindSheetByID(spreadsheet, gridRange.SheetId)
+	if sheet == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	data.Refresh(sheet, gridRange)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is called to update the state of the resource. Config, planned
+// state, and prior state values should be read from the
+// UpdateRequest and new state values set on the UpdateResponse.
+func (r *CellsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state CellsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.write(ctx, data, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to perform update request", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete is called when the provider must delete the resource. Config
+// values may be read from the DeleteRequest.
+//
+// If execution completes without error, the framework will automatically
+// call DeleteResponse.State.RemoveResource(), so it can be omitted
+// from provider logic.
+func (r *CellsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CellsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Writing an empty block over the state clears every managed cell.
+	empty := CellsResourceModel{
+		SpreadsheetID: data.SpreadsheetID,
+		AnchorCell:    data.AnchorCell,
+	}
+	err := r.write(ctx, empty, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to clear cells", err.Error())
+		return
+	}
+}
+
+func (r *CellsResource) write(ctx context.Context, data CellsResourceModel, previous *CellsResourceModel) error {
+	getRequest := r.client.Spreadsheets.Get(data.SpreadsheetID.ValueString())
+	getRequest.Fields("spreadsheetId,sheets.properties")
+	getRequest.Context(ctx)
+	spreadsheet, err := getRequest.Do()
+	if err != nil {
+		return err
+	}
+
+	gridRange, err := data.ToGridRange(spreadsheet)
+	if err != nil {
+		return err
+	}
+
+	request := data.BuildRequest(gridRange, previous)
+	if request == nil {
+		return nil
+	}
+
+	updateRequest := r.client.Spreadsheets.BatchUpdate(data.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{request},
+	})
+	updateRequest.Context(ctx)
+	_, err = updateRequest.Do()
+	return err
+}
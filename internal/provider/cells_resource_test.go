@@ -0,0 +1,383 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestAccCellsResource(t *testing.T) {
+	// The mock only knows about the range A1:C3 of the sheet.
+	grid := [3][3]*sheets.CellData{}
+	for row := range grid {
+		for column := range grid[row] {
+			grid[row][column] = &sheets.CellData{}
+		}
+	}
+	sheetDeleted := false
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v4/spreadsheets/{spreadsheetId}", func(w http.ResponseWriter, r *http.Request) {
+		if sheetDeleted {
+			// Ranges of a deleted sheet can't be parsed.
+			if r.URL.Query().Has("ranges") {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			err := json.NewEncoder(w).Encode(sheets.Spreadsheet{SpreadsheetId: r.PathValue("spreadsheetId")})
+			if err != nil {
+				log.Println(err)
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+			return
+		}
+
+		sheet := &sheets.Sheet{Properties: &sheets.SheetProperties{SheetId: 2, Title: "legend"}}
+		if r.URL.Query().Get("includeGridData") == "true" {
+			data := &sheets.GridData{}
+			for _, row := range grid {
+				data.RowData = append(data.RowData, &sheets.RowData{Values: row[:]})
+			}
+			sheet.Data = []*sheets.GridData{data}
+		}
+
+		res := sheets.Spreadsheet{
+			SpreadsheetId: r.PathValue("spreadsheetId"),
+			Sheets:        []*sheets.Sheet{sheet},
+		}
+		err := json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+	mux.HandleFunc("POST /v4/spreadsheets/{spreadsheetIdUpdate}", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		requestBody := &sheets.BatchUpdateSpreadsheetRequest{}
+		err := json.NewDecoder(r.Body).Decode(requestBody)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		update := requestBody.Requests[0].UpdateCells
+		if update == nil || update.Start.SheetId != 2 || update.Start.RowIndex != 1 || update.Start.ColumnIndex != 1 {
+			t.Errorf("Unexpected request %v", requestBody.Requests[0])
+		} else {
+			fields := strings.Split(update.Fields, ",")
+			for i, row := range update.Rows {
+				for j, cell := range row.Values {
+					target := grid[int(update.Start.RowIndex)+i][int(update.Start.ColumnIndex)+j]
+					for _, field := range fields {
+						switch field {
+						case "userEnteredValue":
+							target.UserEnteredValue = cell.UserEnteredValue
+						case "textFormatRuns":
+							target.TextFormatRuns = cell.TextFormatRuns
+						default:
+							t.Errorf("Unexpected field %s", field)
+						}
+					}
+				}
+			}
+		}
+
+		err = json.NewEncoder(w).Encode(sheets.BatchUpdateSpreadsheetResponse{})
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	config := func(rows string) string {
+		return fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_cells" "test" {
+	spreadsheet_id = "test-spreadsheet-id"
+	anchor_cell = "'legend'!B2"
+	rows = %s
+}
+`, server.URL, rows)
+	}
+
+	legend := `[
+		{
+			cells = [
+				{
+					user_entered_value = { string_value = "Total: see docs" }
+					text_format_runs = [
+						{ start_index = 0, format = { bold = true, foreground_color = "#ff0000" } },
+						{ start_index = 11, link = "https://example.com/docs" },
+					]
+				},
+			]
+		},
+		{
+			cells = [
+				{ user_entered_value = { string_value = "plain" } },
+			]
+		},
+	]`
+
+	value := func(cell *sheets.CellData) string {
+		if cell.UserEnteredValue == nil || cell.UserEnteredValue.StringValue == nil {
+			return ""
+		}
+		return *cell.UserEnteredValue.StringValue
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		CheckDestroy: func(s *terraform.State) error {
+			for _, row := range grid {
+				for _, cell := range row {
+					if value(cell) != "" || len(cell.TextFormatRuns) != 0 {
+						return fmt.Errorf("Expected the cells to be cleared, got %v", cell)
+					}
+				}
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(legend),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_cells.test", "rows.0.cells.0.text_format_runs.0.format.foreground_color", "#ff0000"),
+					func(s *terraform.State) error {
+						runs := grid[1][1].TextFormatRuns
+						if value(grid[1][1]) != "Total: see docs" || value(grid[2][1]) != "plain" {
+							return fmt.Errorf("Unexpected values %v", grid)
+						}
+						if len(runs) != 2 || !runs[0].Format.Bold || runs[1].StartIndex != 11 || runs[1].Format.Link.Uri != "https://example.com/docs" {
+							return fmt.Errorf("Unexpected runs %v", runs)
+						}
+						if len(grid[2][1].TextFormatRuns) != 0 {
+							return fmt.Errorf("Expected no runs in the second row, got %v", grid[2][1].TextFormatRuns)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config:   config(legend),
+				PlanOnly: true,
+			},
+			{
+				// Someone removed the format from the UI, it is applied again.
+				PreConfig: func() {
+					grid[1][1].TextFormatRuns = nil
+				},
+				Config: config(legend),
+				Check: func(s *terraform.State) error {
+					if len(grid[1][1].TextFormatRuns) != 2 {
+						return fmt.Errorf("Expected the runs to be applied again, got %v", grid[1][1].TextFormatRuns)
+					}
+					return nil
+				},
+			},
+			{
+				// The second row is no longer managed, so it is cleared.
+				Config: config(`[{ cells = [{ user_entered_value = { string_value = "Total" } }] }]`),
+				Check: func(s *terraform.State) error {
+					if value(grid[1][1]) != "Total" || len(grid[1][1].TextFormatRuns) != 0 || value(grid[2][1]) != "" {
+						return fmt.Errorf("Unexpected cells %v %v", grid[1][1], grid[2][1])
+					}
+					return nil
+				},
+			},
+			{
+				// The sheet was deleted by hand, along with its cells.
+				PreConfig: func() {
+					sheetDeleted = true
+					grid[1][1] = &sheets.CellData{}
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+				Check: func(s *terraform.State) error {
+					if len(s.RootModule().Resources) != 0 {
+						return fmt.Errorf("Expected the cells to be removed from the state, got %v", s.RootModule().Resources)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccCellsResource_CellData(t *testing.T) {
+	// The mock only knows about the range A1:B2 of the sheet.
+	grid := [2][2]*sheets.CellData{}
+	for row := range grid {
+		for column := range grid[row] {
+			grid[row][column] = &sheets.CellData{}
+		}
+	}
+	var requests []*sheets.Request
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v4/spreadsheets/{spreadsheetId}", func(w http.ResponseWriter, r *http.Request) {
+		sheet := &sheets.Sheet{Properties: &sheets.SheetProperties{SheetId: 0, Title: "Sheet1"}}
+		if r.URL.Query().Get("includeGridData") == "true" {
+			if r.URL.Query().Get("ranges") != "'Sheet1'!A1:B2" {
+				t.Errorf("Unexpected ranges %s", r.URL.Query().Get("ranges"))
+			}
+			data := &sheets.GridData{}
+			for _, row := range grid {
+				data.RowData = append(data.RowData, &sheets.RowData{Values: row[:]})
+			}
+			sheet.Data = []*sheets.GridData{data}
+		}
+
+		res := sheets.Spreadsheet{
+			SpreadsheetId: r.PathValue("spreadsheetId"),
+			Sheets:        []*sheets.Sheet{sheet},
+		}
+		err := json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+	mux.HandleFunc("POST /v4/spreadsheets/{spreadsheetIdUpdate}", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		requestBody := &sheets.BatchUpdateSpreadsheetRequest{}
+		err := json.NewDecoder(r.Body).Decode(requestBody)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		requests = requestBody.Requests
+
+		// The resource always sends every managed field, so the cells can be replaced.
+		update := requestBody.Requests[0].UpdateCells
+		for i, row := range update.Rows {
+			for j, cell := range row.Values {
+				grid[i][j] = cell
+			}
+		}
+
+		err = json.NewEncoder(w).Encode(sheets.BatchUpdateSpreadsheetResponse{})
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	config := fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_cells" "test" {
+	spreadsheet_id = "test-spreadsheet-id"
+	anchor_cell = "A1"
+	rows = [
+		{
+			cells = [
+				{
+					user_entered_value = { string_value = "Docs" }
+					user_entered_format = { text_format = { bold = true }, background_color = "#eeeeee" }
+					hyperlink = "https://example.com/docs"
+				},
+				{
+					user_entered_value = { bool_value = true }
+					data_validation = { condition = { type = "BOOLEAN" } }
+				},
+			]
+		},
+		{
+			cells = [
+				{
+					user_entered_value = { number_value = 1250.5 }
+					user_entered_format = { number_format = { type = "CURRENCY" } }
+					note = "In euros"
+				},
+				{ user_entered_value = { formula_value = "=A2*2" } },
+			]
+		},
+	]
+}
+`, server.URL)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		CheckDestroy: func(s *terraform.State) error {
+			for _, row := range grid {
+				for _, cell := range row {
+					if cell.UserEnteredValue != nil || cell.Note != "" || cell.DataValidation != nil {
+						return fmt.Errorf("Expected the cells to be cleared, got %v", cell)
+					}
+				}
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: func(s *terraform.State) error {
+					if len(requests) != 1 {
+						return fmt.Errorf("Expected a single request, got %d", len(requests))
+					}
+					expected := "dataValidation,note,userEnteredFormat.backgroundColor,userEnteredFormat.numberFormat,userEnteredFormat.textFormat.bold,userEnteredFormat.textFormat.link,userEnteredValue"
+					if fields := requests[0].UpdateCells.Fields; fields != expected {
+						return fmt.Errorf("Expected fields %s, got %s", expected, fields)
+					}
+					if *grid[1][0].UserEnteredValue.NumberValue != 1250.5 || grid[0][0].UserEnteredFormat.TextFormat.Link.Uri != "https://example.com/docs" {
+						return fmt.Errorf("Unexpected cells %v", grid)
+					}
+					return nil
+				},
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+			{
+				// Someone typed over the formula and removed the checkbox, both are restored.
+				PreConfig: func() {
+					value := "2501"
+					grid[1][1] = &sheets.CellData{UserEnteredValue: &sheets.ExtendedValue{StringValue: &value}}
+					grid[0][1].DataValidation = nil
+				},
+				Config: config,
+				Check: func(s *terraform.State) error {
+					if grid[1][1].UserEnteredValue.FormulaValue == nil || grid[0][1].DataValidation == nil {
+						return fmt.Errorf("Expected the cells to be restored, got %v %v", grid[1][1], grid[0][1])
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,777 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"google.golang.org/api/sheets/v4"
+)
+
+var _ resource.ResourceWithConfigure = &ChartResource{}
+var _ resource.ResourceWithImportState = &ChartResource{}
+
+// Default size of the charts created from the google sheets UI.
+const (
+	DefaultChartWidthPixels  = 600
+	DefaultChartHeightPixels = 371
+)
+
+func NewChartResource() resource.Resource {
+	return &ChartResource{}
+}
+
+type ChartResource struct {
+	client *sheets.Service
+}
+
+type ChartResourceModel struct {
+	SpreadsheetID types.String        `tfsdk:"spreadsheet_id"`
+	ChartID       types.Int64         `tfsdk:"chart_id"`
+	Title         types.String        `tfsdk:"title"`
+	BasicChart    *BasicChartModel    `tfsdk:"basic_chart"`
+	PieChart      *PieChartModel      `tfsdk:"pie_chart"`
+	Position      *ChartPositionModel `tfsdk:"position"`
+}
+
+type BasicChartModel struct {
+	ChartType       types.String       `tfsdk:"chart_type"`
+	LegendPosition  types.String       `tfsdk:"legend_position"`
+	HeaderCount     types.Int64        `tfsdk:"header_count"`
+	Domain          types.String       `tfsdk:"domain"`
+	Series          []ChartSeriesModel `tfsdk:"series"`
+	BottomAxisTitle types.String       `tfsdk:"bottom_axis_title"`
+	LeftAxisTitle   types.String       `tfsdk:"left_axis_title"`
+}
+
+type ChartSeriesModel struct {
+	Range      types.String `tfsdk:"range"`
+	TargetAxis types.String `tfsdk:"target_axis"`
+	Color      types.String `tfsdk:"color"`
+}
+
+type PieChartModel struct {
+	LegendPosition   types.String  `tfsdk:"legend_position"`
+	Domain           types.String  `tfsdk:"domain"`
+	Series           types.String  `tfsdk:"series"`
+	PieHole          types.Float64 `tfsdk:"pie_hole"`
+	ThreeDimensional types.Bool    `tfsdk:"three_dimensional"`
+}
+
+type ChartPositionModel struct {
+	Overlay  *OverlayPositionModel `tfsdk:"overlay"`
+	NewSheet types.Bool            `tfsdk:"new_sheet"`
+}
+
+type OverlayPositionModel struct {
+	AnchorCell    types.String `tfsdk:"anchor_cell"`
+	OffsetXPixels types.Int64  `tfsdk:"offset_x_pixels"`
+	OffsetYPixels types.Int64  `tfsdk:"offset_y_pixels"`
+	WidthPixels   types.Int64  `tfsdk:"width_pixels"`
+	HeightPixels  types.Int64  `tfsdk:"height_pixels"`
+}
+
+// chartData converts a range in A1 notation into the source of a chart.
+func chartData(spreadsheet *sheets.Spreadsheet, a1 types.String) (*sheets.ChartData, error) {
+	gridRange, err := GridRangeFromA1(spreadsheet, a1.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	return &sheets.ChartData{
+		SourceRange: &sheets.ChartSourceRange{
+			Sources: []*sheets.GridRange{gridRange},
+		},
+	}, nil
+}
+
+// refreshChartData returns the first source of the chart data in A1 notation.
+func refreshChartData(spreadsheet *sheets.Spreadsheet, current types.String, data *sheets.ChartData) (types.String, error) {
+	if data == nil || data.SourceRange == nil || len(data.SourceRange.Sources) == 0 {
+		return types.StringNull(), nil
+	}
+	return RefreshA1(spreadsheet, current, data.SourceRange.Sources[0])
+}
+
+func (m *BasicChartModel) ToBasicChartSpec(spreadsheet *sheets.Spreadsheet) (*sheets.BasicChartSpec, error) {
+	spec := &sheets.BasicChartSpec{
+		ChartType:      m.ChartType.ValueString(),
+		LegendPosition: m.LegendPosition.ValueString(),
+		HeaderCount:    m.HeaderCount.ValueInt64(),
+	}
+
+	domain, err := chartData(spreadsheet, m.Domain)
+	if err != nil {
+		return nil, err
+	}
+	spec.Domains = []*sheets.BasicChartDomain{{Domain: domain}}
+
+	for _, series := range m.Series {
+		data, err := chartData(spreadsheet, series.Range)
+		if err != nil {
+			return nil, err
+		}
+		spec.Series = append(spec.Series, &sheets.BasicChartSeries{
+			Series:     data,
+			TargetAxis: series.TargetAxis.ValueString(),
+			Color:      ColorFromValue(series.Color),
+		})
+	}
+
+	if !m.BottomAxisTitle.IsNull() {
+		spec.Axis = append(spec.Axis, &sheets.BasicChartAxis{Position: "BOTTOM_AXIS", Title: m.BottomAxisTitle.ValueString()})
+	}
+	if !m.LeftAxisTitle.IsNull() {
+		spec.Axis = append(spec.Axis, &sheets.BasicChartAxis{Position: "LEFT_AXIS", Title: m.LeftAxisTitle.ValueString()})
+	}
+
+	return spec, nil
+}
+
+// Refresh returns the model updated with the spec returned by the API.
+// Optional attributes the API fills with defaults are only refreshed when they are set.
+func (m *BasicChartModel) Refresh(spreadsheet *sheets.Spreadsheet, spec *sheets.BasicChartSpec) (*BasicChartModel, error) {
+	if spec == nil {
+		return nil, nil
+	}
+	if m == nil {
+		m = &BasicChartModel{}
+	}
+
+	var err error
+	m.ChartType = types.StringValue(spec.ChartType)
+	if !m.LegendPosition.IsNull() {
+		m.LegendPosition = types.StringValue(spec.LegendPosition)
+	}
+	if !m.HeaderCount.IsNull() {
+		m.HeaderCount = types.Int64Value(spec.HeaderCount)
+	}
+
+	var domain *sheets.ChartData
+	if len(spec.Domains) > 0 {
+		domain = spec.Domains[0].Domain
+	}
+	m.Domain, err = refreshChartData(spreadsheet, m.Domain, domain)
+	if err != nil {
+		return m, err
+	}
+
+	if len(m.Series) != len(spec.Series) {
+		m.Series = make([]ChartSeriesModel, len(spec.Series))
+	}
+	for i, series := range spec.Series {
+		current := &m.Series[i]
+		current.Range, err = refreshChartData(spreadsheet, current.Range, series.Series)
+		if err != nil {
+			return m, err
+		}
+		if !current.TargetAxis.IsNull() {
+			current.TargetAxis = types.StringValue(series.TargetAxis)
+		}
+		if !current.Color.IsNull() {
+			current.Color = RefreshColor(current.Color, series.Color)
+		}
+	}
+
+	bottomAxisTitle, leftAxisTitle := "", ""
+	for _, axis := range spec.Axis {
+		switch axis.Position {
+		case "BOTTOM_AXIS":
+			bottomAxisTitle = axis.Title
+		case "LEFT_AXIS":
+			leftAxisTitle = axis.Title
+		}
+	}
+	m.BottomAxisTitle = RefreshString(m.BottomAxisTitle, bottomAxisTitle)
+	m.LeftAxisTitle = RefreshString(m.LeftAxisTitle, leftAxisTitle)
+
+	return m, nil
+}
+
+func (m *PieChartModel) ToPieChartSpec(spreadsheet *sheets.Spreadsheet) (*sheets.PieChartSpec, error) {
+	domain, err := chartData(spreadsheet, m.Domain)
+	if err != nil {
+		return nil, err
+	}
+	series, err := chartData(spreadsheet, m.Series)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sheets.PieChartSpec{
+		Domain:           domain,
+		Series:           series,
+		LegendPosition:   m.LegendPosition.ValueString(),
+		PieHole:          m.PieHole.ValueFloat64(),
+		ThreeDimensional: m.ThreeDimensional.ValueBool(),
+	}, nil
+}
+
+// Refresh returns the model updated with the spec returned by the API.
+func (m *PieChartModel) Refresh(spreadsheet *sheets.Spreadsheet, spec *sheets.PieChartSpec) (*PieChartModel, error) {
+	if spec == nil {
+		return nil, nil
+	}
+	if m == nil {
+		m = &PieChartModel{}
+	}
+
+	var err error
+	if !m.LegendPosition.IsNull() {
+		m.LegendPosition = types.StringValue(spec.LegendPosition)
+	}
+	m.Domain, err = refreshChartData(spreadsheet, m.Domain, spec.Domain)
+	if err != nil {
+		return m, err
+	}
+	m.Series, err = refreshChartData(spreadsheet, m.Series, spec.Series)
+	if err != nil {
+		return m, err
+	}
+	if !m.PieHole.IsNull() || spec.PieHole != 0 {
+		m.PieHole = types.Float64Value(spec.PieHole)
+	}
+	m.ThreeDimensional = RefreshBool(m.ThreeDimensional, spec.ThreeDimensional)
+
+	return m, nil
+}
+
+func (m ChartResourceModel) ToChartSpec(spreadsheet *sheets.Spreadsheet) (*sheets.ChartSpec, error) {
+	var err error
+	spec := &sheets.ChartSpec{
+		Title: m.Title.ValueString(),
+	}
+	if m.BasicChart != nil {
+		spec.BasicChart, err = m.BasicChart.ToBasicChartSpec(spreadsheet)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if m.PieChart != nil {
+		spec.PieChart, err = m.PieChart.ToPieChartSpec(spreadsheet)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return spec, nil
+}
+
+func (m *ChartPositionModel) ToEmbeddedObjectPosition(spreadsheet *sheets.Spreadsheet) (*sheets.EmbeddedObjectPosition, error) {
+	if m.Overlay == nil {
+		if !m.NewSheet.ValueBool() {
+			return nil, fmt.Errorf("either overlay must be set or new_sheet must be true")
+		}
+		return &sheets.EmbeddedObjectPosition{NewSheet: true}, nil
+	}
+
+	anchor, err := GridRangeFromA1(spreadsheet, m.Overlay.AnchorCell.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	return &sheets.EmbeddedObjectPosition{
+		OverlayPosition: &sheets.OverlayPosition{
+			AnchorCell: &sheets.GridCoordinate{
+				SheetId:         anchor.SheetId,
+				RowIndex:        anchor.StartRowIndex,
+				ColumnIndex:     anchor.StartColumnIndex,
+				ForceSendFields: []string{"SheetId", "RowIndex", "ColumnIndex"},
+			},
+			OffsetXPixels: m.Overlay.OffsetXPixels.ValueInt64(),
+			OffsetYPixels: m.Overlay.OffsetYPixels.ValueInt64(),
+			WidthPixels:   m.Overlay.WidthPixels.ValueInt64(),
+			HeightPixels:  m.Overlay.HeightPixels.ValueInt64(),
+		},
+	}, nil
+}
+
+// Refresh returns the model updated with the position returned by the API.
+func (m *ChartPositionModel) Refresh(spreadsheet *sheets.Spreadsheet, position *sheets.EmbeddedObjectPosition) (*ChartPositionModel, error) {
+	if position == nil {
+		return m, nil
+	}
+	if m == nil {
+		m = &ChartPositionModel{}
+	}
+
+	overlay := position.OverlayPosition
+	if overlay == nil {
+		m.Overlay = nil
+		m.NewSheet = types.BoolValue(true)
+		return m, nil
+	}
+
+	if m.Overlay == nil {
+		m.Overlay = &OverlayPositionModel{}
+	}
+	m.NewSheet = types.BoolNull()
+
+	var err error
+	if overlay.AnchorCell != nil {
+		m.Overlay.AnchorCell, err = RefreshA1(spreadsheet, m.Overlay.AnchorCell, &sheets.GridRange{
+			SheetId:          overlay.AnchorCell.SheetId,
+			StartRowIndex:    overlay.AnchorCell.RowIndex,
+			EndRowIndex:      overlay.AnchorCell.RowIndex + 1,
+			StartColumnIndex: overlay.AnchorCell.ColumnIndex,
+			EndColumnIndex:   overlay.AnchorCell.ColumnIndex + 1,
+		})
+		if err != nil {
+			return m, err
+		}
+	}
+	m.Overlay.OffsetXPixels = types.Int64Value(overlay.OffsetXPixels)
+	m.Overlay.OffsetYPixels = types.Int64Value(overlay.OffsetYPixels)
+	m.Overlay.WidthPixels = types.Int64Value(overlay.WidthPixels)
+	m.Overlay.HeightPixels = types.Int64Value(overlay.HeightPixels)
+
+	return m, nil
+}
+
+// Refresh updates the model with the chart returned by the API.
+func (m *ChartResourceModel) Refresh(spreadsheet *sheets.Spreadsheet, chart *sheets.EmbeddedChart) error {
+	var err error
+	m.ChartID = types.Int64Value(chart.ChartId)
+	m.Position, err = m.Position.Refresh(spreadsheet, chart.Position)
+	if err != nil {
+		return err
+	}
+
+	spec := chart.Spec
+	if spec == nil {
+		spec = &sheets.ChartSpec{}
+	}
+	m.Title = RefreshString(m.Title, spec.Title)
+	m.BasicChart, err = m.BasicChart.Refresh(spreadsheet, spec.BasicChart)
+	if err != nil {
+		return err
+	}
+	m.PieChart, err = m.PieChart.Refresh(spreadsheet, spec.PieChart)
+	return err
+}
+
+// FindChart returns the chart with the given id. It returns nil if it doesn't exist.
+func FindChart(spreadsheet *sheets.Spreadsheet, id int64) *sheets.EmbeddedChart {
+	for _, sheet := range spreadsheet.Sheets {
+		for _, chart := range sheet.Charts {
+			if chart.ChartId == id {
+				return chart
+			}
+		}
+	}
+	return nil
+}
+
+func (r *ChartResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_chart"
+}
+
+func (r *ChartResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	legendPosition := func() schema.StringAttribute {
+		return schema.StringAttribute{
+			MarkdownDescription: "Where the legend is drawn. One of `BOTTOM_LEGEND`, `LEFT_LEGEND`, `RIGHT_LEGEND`, `TOP_LEGEND` or `NO_LEGEND`",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("BOTTOM_LEGEND", "LEFT_LEGEND", "RIGHT_LEGEND", "TOP_LEGEND", "NO_LEGEND"),
+			},
+		}
+	}
+	sourceRange := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			MarkdownDescription: description + " in A1 notation. Use the sheet title to point to a specific sheet.",
+			Required:            true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages a chart embedded in a spreadsheet.
+
+Basic charts draw one or more series against a domain. A ` + "`LINE`" + ` chart with dates in the domain is drawn as a time series.
+Changes made to the chart from the google sheets UI are reverted on the next apply.`,
+
+		Attributes: map[string]schema.Attribute{
+			"spreadsheet_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID for the spreadsheet. It can be obtained from the URL.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"chart_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the chart",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "The title of the chart",
+				Optional:            true,
+			},
+			"basic_chart": schema.SingleNestedAttribute{
+				MarkdownDescription: "A bar, line, column or area chart",
+				Optional:            true,
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(path.MatchRoot("pie_chart")),
+				},
+				Attributes: map[string]schema.Attribute{
+					"chart_type": schema.StringAttribute{
+						MarkdownDescription: "One of `BAR`, `LINE`, `COLUMN` or `AREA`",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("BAR", "LINE", "COLUMN", "AREA"),
+						},
+					},
+					"legend_position": legendPosition(),
+					"header_count": schema.Int64Attribute{
+						MarkdownDescription: "The number of rows or columns in the data that are headers. If not set, google sheets guesses it",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"domain": sourceRange("The range with the values of the horizontal axis, or the vertical axis for bar charts,"),
+					"series": schema.ListNestedAttribute{
+						MarkdownDescription: "The data to draw",
+						Required:            true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"range": sourceRange("The range with the values of the series"),
+								"target_axis": schema.StringAttribute{
+									MarkdownDescription: "The axis the values are drawn against. One of `LEFT_AXIS`, `RIGHT_AXIS` or `BOTTOM_AXIS`",
+									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.OneOf("LEFT_AXIS", "RIGHT_AXIS", "BOTTOM_AXIS"),
+									},
+								},
+								"color": schema.StringAttribute{
+									MarkdownDescription: "The color of the series in #RRGGBB notation",
+									Optional:            true,
+									Validators: []validator.String{
+										hexColorValidator(),
+									},
+								},
+							},
+						},
+					},
+					"bottom_axis_title": schema.StringAttribute{
+						MarkdownDescription: "The title of the horizontal axis",
+						Optional:            true,
+					},
+					"left_axis_title": schema.StringAttribute{
+						MarkdownDescription: "The title of the vertical axis",
+						Optional:            true,
+					},
+				},
+			},
+			"pie_chart": schema.SingleNestedAttribute{
+				MarkdownDescription: "A pie or donut chart",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"legend_position": legendPosition(),
+					"domain":          sourceRange("The range with the labels of the slices"),
+					"series":          sourceRange("The range with the size of the slices"),
+					"pie_hole": schema.Float64Attribute{
+						MarkdownDescription: "The size of the hole in the middle, as a fraction of the chart. Set it to draw a donut chart",
+						Optional:            true,
+						Validators: []validator.Float64{
+							float64validator.Between(0, 1),
+						},
+					},
+					"three_dimensional": schema.BoolAttribute{
+						MarkdownDescription: "Draws the chart in 3D",
+						Optional:            true,
+					},
+				},
+			},
+			"position": schema.SingleNestedAttribute{
+				MarkdownDescription: "Where the chart is placed. Changing it creates a new chart.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"overlay": schema.SingleNestedAttribute{
+						MarkdownDescription: "Places the chart over the cells of a sheet",
+						Optional:            true,
+						Validators: []validator.Object{
+							objectvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("new_sheet")),
+						},
+						Attributes: map[string]schema.Attribute{
+							"anchor_cell": schema.StringAttribute{
+								MarkdownDescription: "The cell the top left corner of the chart is anchored to in A1 notation, like `'Sheet 1'!E2`",
+								Required:            true,
+							},
+							"offset_x_pixels": schema.Int64Attribute{
+								MarkdownDescription: "The horizontal offset from the anchor cell",
+								Optional:            true,
+								Computed:            true,
+								Default:             int64default.StaticInt64(0),
+								Validators: []validator.Int64{
+									int64validator.AtLeast(0),
+								},
+							},
+							"offset_y_pixels": schema.Int64Attribute{
+								MarkdownDescription: "The vertical offset from the anchor cell",
+								Optional:            true,
+								Computed:            true,
+								Default:             int64default.StaticInt64(0),
+								Validators: []validator.Int64{
+									int64validator.AtLeast(0),
+								},
+							},
+							"width_pixels": schema.Int64Attribute{
+								MarkdownDescription: fmt.Sprintf("The width of the chart. Defaults to %d", DefaultChartWidthPixels),
+								Optional:            true,
+								Computed:            true,
+								Default:             int64default.StaticInt64(DefaultChartWidthPixels),
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+								},
+							},
+							"height_pixels": schema.Int64Attribute{
+								MarkdownDescription: fmt.Sprintf("The height of the chart. Defaults to %d", DefaultChartHeightPixels),
+								Optional:            true,
+								Computed:            true,
+								Default:             int64default.StaticInt64(DefaultChartHeightPixels),
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+								},
+							},
+						},
+					},
+					"new_sheet": schema.BoolAttribute{
+						MarkdownDescription: "Places the chart in its own sheet. It must be `true` when set",
+						Optional:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure implements resource.ResourceWithConfigure.
+func (r *ChartResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sheets.Service)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Type",
+			fmt.Sprintf("Expected *sheets.Service, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create is called when the provider must create a new resource. Config
+// and planned state values should be read from the
+// CreateRequest and new state values set on the CreateResponse.
+func (r *ChartResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ChartResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spreadsheet, err := r.getSpreadsheet(ctx, data.SpreadsheetID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read data,", err.Error())
+		return
+	}
+
+	spec, err := data.ToChartSpec(spreadsheet)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid range", err.Error())
+		return
+	}
+	position, err := data.Position.ToEmbeddedObjectPosition(spreadsheet)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid position", err.Error())
+		return
+	}
+
+	createRequest := r.client.Spreadsheets.BatchUpdate(data.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{AddChart: &sheets.AddChartRequest{
+				Chart: &sheets.EmbeddedChart{
+					Spec:     spec,
+					Position: position,
+				},
+			}},
+		},
+	})
+	createRequest.Context(ctx)
+	createResponse, err := createRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create chart", err.Error())
+		return
+	}
+
+	data.ChartID = types.Int64Value(createResponse.Replies[0].AddChart.Chart.ChartId)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (r *ChartResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ":", 2)
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError("ID is not correct", "The ID must be a <spreadsheet_id>:<chart_id>, but it was "+req.ID)
+		return
+	}
+
+	id, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("ID is not correct", "The chart id must be a number, but it was "+parts[1])
+		return
+	}
+
+	data := ChartResourceModel{
+		SpreadsheetID: basetypes.NewStringValue(parts[0]),
+		ChartID:       basetypes.NewInt64Value(id),
+		Title:         basetypes.NewStringNull(),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read is called when the provider must read resource values in order
+// to update state. Planned state values should be read from the
+// ReadRequest and new state values set on the ReadResponse.
+func (r *ChartResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ChartResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spreadsheet, err := r.getSpreadsheet(ctx, data.SpreadsheetID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read data,", err.Error())
+		return
+	}
+
+	chart := FindChart(spreadsheet, data.ChartID.ValueInt64())
+	if chart == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	err = data.Refresh(spreadsheet, chart)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read chart", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is called to update the state of the resource. Config, planned
+// state, and prior state values should be read from the
+// UpdateRequest and new state values set on the UpdateResponse.
+func (r *ChartResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ChartResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spreadsheet, err := r.getSpreadsheet(ctx, data.SpreadsheetID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read data,", err.Error())
+		return
+	}
+
+	spec, err := data.ToChartSpec(spreadsheet)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid range", err.Error())
+		return
+	}
+
+	updateRequest := r.client.Spreadsheets.BatchUpdate(data.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{UpdateChartSpec: &sheets.UpdateChartSpecRequest{
+				ChartId: data.ChartID.ValueInt64(),
+				Spec:    spec,
+			}},
+		},
+	})
+	updateRequest.Context(ctx)
+	_, err = updateRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to perform update request", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete is called when the provider must delete the resource. Config
+// values may be read from the DeleteRequest.
+//
+// If execution completes without error, the framework will automatically
+// call DeleteResponse.State.RemoveResource(), so it can be omitted
+// from provider logic.
+func (r *ChartResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ChartResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteRequest := r.client.Spreadsheets.BatchUpdate(data.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{DeleteEmbeddedObject: &sheets.DeleteEmbeddedObjectRequest{
+				ObjectId: data.ChartID.ValueInt64(),
+			}},
+		},
+	})
+	deleteRequest.Context(ctx)
+	_, err := deleteRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to delete chart", err.Error())
+		return
+	}
+}
+
+func (r *ChartResource) getSpreadsheet(ctx context.Context, spreadsheetID string) (*sheets.Spreadsheet, error) {
+	getRequest := r.client.Spreadsheets.Get(spreadsheetID)
+	getRequest.Fields("spreadsheetId,sheets(properties,charts)")
+	getRequest.Context(ctx)
+	return getRequest.Do()
+}
@@ -0,0 +1,233 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestAccChartResource(t *testing.T) {
+	var charts []*sheets.EmbeddedChart
+	var updates int
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v4/spreadsheets/{spreadsheetId}", func(w http.ResponseWriter, r *http.Request) {
+		res := sheets.Spreadsheet{
+			SpreadsheetId: r.PathValue("spreadsheetId"),
+			Sheets: []*sheets.Sheet{
+				{
+					Properties: &sheets.SheetProperties{SheetId: 2, Title: "access report"},
+					Charts:     charts,
+				},
+			},
+		}
+		err := json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+	mux.HandleFunc("POST /v4/spreadsheets/{spreadsheetIdUpdate}", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		requestBody := &sheets.BatchUpdateSpreadsheetRequest{}
+		err := json.NewDecoder(r.Body).Decode(requestBody)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		res := sheets.BatchUpdateSpreadsheetResponse{
+			SpreadsheetId: strings.Split(r.PathValue("spreadsheetIdUpdate"), ":")[0],
+			Replies:       []*sheets.Response{{}},
+		}
+		request := requestBody.Requests[0]
+		switch {
+		case request.AddChart != nil:
+			chart := request.AddChart.Chart
+			chart.ChartId = 7
+			charts = []*sheets.EmbeddedChart{chart}
+			res.Replies[0].AddChart = &sheets.AddChartResponse{Chart: chart}
+		case request.UpdateChartSpec != nil:
+			updates++
+			charts[0].Spec = request.UpdateChartSpec.Spec
+		case request.DeleteEmbeddedObject != nil:
+			charts = nil
+		default:
+			t.Errorf("Unexpected request %v", request)
+		}
+
+		err = json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	basicConfig := fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_chart" "test" {
+	spreadsheet_id = "test-spreadsheet-id"
+	title = "Weekly access"
+	basic_chart = {
+		chart_type = "LINE"
+		header_count = 1
+		domain = "'access report'!A1:A10"
+		series = [{
+			range = "'access report'!B1:B10"
+			color = "#356854"
+		}, {
+			range = "'access report'!C1:C10"
+			target_axis = "RIGHT_AXIS"
+		}]
+		bottom_axis_title = "Week"
+	}
+	position = {
+		overlay = {
+			anchor_cell = "'access report'!E2"
+		}
+	}
+}
+`, server.URL)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		CheckDestroy: func(s *terraform.State) error {
+			if len(charts) != 0 {
+				return fmt.Errorf("Expected the chart to be deleted")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: basicConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_chart.test", "chart_id", "7"),
+					resource.TestCheckResourceAttr("gsheets_chart.test", "position.overlay.width_pixels", "600"),
+					resource.TestCheckResourceAttr("gsheets_chart.test", "basic_chart.series.#", "2"),
+					resource.TestCheckNoResourceAttr("gsheets_chart.test", "basic_chart.legend_position"),
+					func(s *terraform.State) error {
+						anchor := charts[0].Position.OverlayPosition.AnchorCell
+						if anchor.SheetId != 2 || anchor.RowIndex != 1 || anchor.ColumnIndex != 4 {
+							return fmt.Errorf("Unexpected anchor %v", anchor)
+						}
+						spec := charts[0].Spec.BasicChart
+						if spec.Series[1].Series.SourceRange.Sources[0].StartColumnIndex != 2 {
+							return fmt.Errorf("Unexpected series %v", spec.Series[1].Series.SourceRange.Sources[0])
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// The chart was edited from the UI, the spec must be restored.
+				PreConfig: func() {
+					charts[0].Spec.Title = "edited"
+					charts[0].Spec.BasicChart.ChartType = "COLUMN"
+					// Values filled in by google sheets are not managed.
+					charts[0].Spec.BasicChart.LegendPosition = "RIGHT_LEGEND"
+					charts[0].Spec.BasicChart.Series[1].Color = &sheets.Color{Red: 1}
+				},
+				Config: basicConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_chart.test", "title", "Weekly access"),
+					resource.TestCheckResourceAttr("gsheets_chart.test", "basic_chart.chart_type", "LINE"),
+					func(s *terraform.State) error {
+						if updates != 1 || charts[0].Spec.Title != "Weekly access" {
+							return fmt.Errorf("Expected the spec to be updated once, got %d updates", updates)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:                         "gsheets_chart.test",
+				ImportState:                          true,
+				ImportStateId:                        "test-spreadsheet-id:7",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "chart_id",
+				ImportStateVerifyIgnore:              []string{"basic_chart.header_count", "basic_chart.series.0.color", "basic_chart.series.1.target_axis"},
+			},
+			{
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_chart" "test" {
+	spreadsheet_id = "test-spreadsheet-id"
+	title = "Access by team"
+	pie_chart = {
+		domain = "'access report'!A1:A10"
+		series = "'access report'!B1:B10"
+		pie_hole = 0.5
+	}
+	position = {
+		overlay = {
+			anchor_cell = "'access report'!E2"
+		}
+	}
+}
+`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_chart.test", "chart_id", "7"),
+					resource.TestCheckNoResourceAttr("gsheets_chart.test", "basic_chart"),
+					resource.TestCheckResourceAttr("gsheets_chart.test", "pie_chart.pie_hole", "0.5"),
+					func(s *terraform.State) error {
+						if charts[0].Spec.BasicChart != nil || charts[0].Spec.PieChart == nil {
+							return fmt.Errorf("Expected a pie chart, got %v", charts[0].Spec)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_chart" "test" {
+	spreadsheet_id = "test-spreadsheet-id"
+	pie_chart = {
+		domain = "'access report'!A1:A10"
+		series = "'access report'!B1:B10"
+	}
+	position = {
+		new_sheet = true
+	}
+}
+`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_chart.test", "position.new_sheet", "true"),
+					func(s *terraform.State) error {
+						if !charts[0].Position.NewSheet {
+							return fmt.Errorf("Expected the chart to be created in a new sheet")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
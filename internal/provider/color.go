@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/api/sheets/v4"
+)
+
+var hexColorRegexp = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// hexColorValidator ensures colors follow the #RRGGBB notation.
+func hexColorValidator() validator.String {
+	return stringvalidator.RegexMatches(hexColorRegexp, "must be a color in #RRGGBB notation")
+}
+
+// ParseHexColor converts a color in #RRGGBB notation into a google sheets color.
+func ParseHexColor(hex string) (*sheets.Color, error) {
+	if !hexColorRegexp.MatchString(hex) {
+		return nil, fmt.Errorf("invalid color %q, it must be in #RRGGBB notation", hex)
+	}
+	rgb, err := strconv.ParseUint(hex[1:], 16, 32)
+	if err != nil {
+		return nil, err
+	}
+	return &sheets.Color{
+		Red:   float64(rgb>>16&0xFF) / 255,
+		Green: float64(rgb>>8&0xFF) / 255,
+		Blue:  float64(rgb&0xFF) / 255,
+	}, nil
+}
+
+// FormatHexColor is the inverse of ParseHexColor. Transparency is ignored.
+func FormatHexColor(color *sheets.Color) string {
+	channel := func(value float64) int {
+		return int(math.Round(math.Max(0, math.Min(1, value)) * 255))
+	}
+	return fmt.Sprintf("#%02X%02X%02X", channel(color.Red), channel(color.Green), channel(color.Blue))
+}
+
+// ColorFromValue converts an optional color. It returns nil when the value is null.
+func ColorFromValue(value types.String) *sheets.Color {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	// The value has already been validated by the schema.
+	color, _ := ParseHexColor(value.ValueString())
+	return color
+}
+
+// RefreshColor returns the color in #RRGGBB notation, keeping the current value if it only differs in case.
+func RefreshColor(current types.String, color *sheets.Color) types.String {
+	if color == nil {
+		return types.StringNull()
+	}
+	hex := FormatHexColor(color)
+	if strings.EqualFold(current.ValueString(), hex) {
+		return current
+	}
+	return types.StringValue(hex)
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestHexColorRoundTrip(t *testing.T) {
+	for _, hex := range []string{"#000000", "#FFFFFF", "#FF0000", "#00FF00", "#0000FF", "#F4CCCC", "#0B5394"} {
+		t.Run(hex, func(t *testing.T) {
+			color, err := ParseHexColor(hex)
+			if err != nil {
+				t.Fatalf("Unexpected error %s", err)
+			}
+			if result := FormatHexColor(color); result != hex {
+				t.Errorf("Expected %s, got %s", hex, result)
+			}
+		})
+	}
+}
+
+func TestParseHexColorInvalid(t *testing.T) {
+	for _, hex := range []string{"", "FF0000", "#FF00", "#GG0000", "red"} {
+		t.Run(hex, func(t *testing.T) {
+			if _, err := ParseHexColor(hex); err == nil {
+				t.Errorf("Expected error for %q", hex)
+			}
+		})
+	}
+}
+
+func TestRefreshColor(t *testing.T) {
+	color, _ := ParseHexColor("#f4cccc")
+
+	if result := RefreshColor(types.StringValue("#f4cccc"), color); result.ValueString() != "#f4cccc" {
+		t.Errorf("Expected the current value to be kept, got %s", result)
+	}
+	if result := RefreshColor(types.StringValue("#000000"), color); result.ValueString() != "#F4CCCC" {
+		t.Errorf("Expected the new color, got %s", result)
+	}
+	if result := RefreshColor(types.StringValue("#000000"), nil); !result.IsNull() {
+		t.Errorf("Expected null, got %s", result)
+	}
+}
@@ -0,0 +1,724 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"google.golang.org/api/sheets/v4"
+)
+
+var _ resource.ResourceWithConfigure = &ConditionalFormatRuleResource{}
+var _ resource.ResourceWithImportState = &ConditionalFormatRuleResource{}
+var _ resource.ResourceWithModifyPlan = &ConditionalFormatRuleResource{}
+
+func NewConditionalFormatRuleResource() resource.Resource {
+	return &ConditionalFormatRuleResource{}
+}
+
+type ConditionalFormatRuleResource struct {
+	client *sheets.Service
+}
+
+type ConditionalFormatRuleResourceModel struct {
+	SpreadsheetID types.String       `tfsdk:"spreadsheet_id"`
+	SheetID       types.Int64        `tfsdk:"sheet_id"`
+	Index         types.Int64        `tfsdk:"index"`
+	Ranges        []types.String     `tfsdk:"ranges"`
+	BooleanRule   *BooleanRuleModel  `tfsdk:"boolean_rule"`
+	GradientRule  *GradientRuleModel `tfsdk:"gradient_rule"`
+}
+
+type BooleanRuleModel struct {
+	Condition *BooleanConditionModel  `tfsdk:"condition"`
+	Format    *ConditionalFormatModel `tfsdk:"format"`
+}
+
+type ConditionalFormatModel struct {
+	BackgroundColor types.String                `tfsdk:"background_color"`
+	TextFormat      *ConditionalTextFormatModel `tfsdk:"text_format"`
+}
+
+type ConditionalTextFormatModel struct {
+	ForegroundColor types.String `tfsdk:"foreground_color"`
+	Bold            types.Bool   `tfsdk:"bold"`
+	Italic          types.Bool   `tfsdk:"italic"`
+	Strikethrough   types.Bool   `tfsdk:"strikethrough"`
+	Underline       types.Bool   `tfsdk:"underline"`
+}
+
+type GradientRuleModel struct {
+	Minpoint *InterpolationPointModel `tfsdk:"minpoint"`
+	Midpoint *InterpolationPointModel `tfsdk:"midpoint"`
+	Maxpoint *InterpolationPointModel `tfsdk:"maxpoint"`
+}
+
+type InterpolationPointModel struct {
+	Color types.String `tfsdk:"color"`
+	Type  types.String `tfsdk:"type"`
+	Value types.String `tfsdk:"value"`
+}
+
+func (m *ConditionalFormatModel) ToCellFormat() *sheets.CellFormat {
+	if m == nil {
+		return nil
+	}
+	format := &sheets.CellFormat{
+		BackgroundColor: ColorFromValue(m.BackgroundColor),
+	}
+	if m.TextFormat != nil {
+		textFormat := sheets.TextFormat{
+			ForegroundColor: ColorFromValue(m.TextFormat.ForegroundColor),
+			Bold:            m.TextFormat.Bold.ValueBool(),
+			Italic:          m.TextFormat.Italic.ValueBool(),
+			Strikethrough:   m.TextFormat.Strikethrough.ValueBool(),
+			Underline:       m.TextFormat.Underline.ValueBool(),
+		}
+		// Google sheets doesn't return empty text formats.
+		if textFormat.ForegroundColor != nil || textFormat.Bold || textFormat.Italic || textFormat.Strikethrough || textFormat.Underline {
+			format.TextFormat = &textFormat
+		}
+	}
+	return format
+}
+
+// Refresh updates the model with the format returned by the API.
+func (m *ConditionalFormatModel) Refresh(format *sheets.CellFormat) *ConditionalFormatModel {
+	if format == nil {
+		return nil
+	}
+	if m == nil {
+		m = &ConditionalFormatModel{}
+	}
+	m.BackgroundColor = RefreshColor(m.BackgroundColor, format.BackgroundColor)
+	if format.TextFormat == nil {
+		m.TextFormat = nil
+		return m
+	}
+	if m.TextFormat == nil {
+		m.TextFormat = &ConditionalTextFormatModel{}
+	}
+	m.TextFormat.ForegroundColor = RefreshColor(m.TextFormat.ForegroundColor, format.TextFormat.ForegroundColor)
+	m.TextFormat.Bold = RefreshBool(m.TextFormat.Bold, format.TextFormat.Bold)
+	m.TextFormat.Italic = RefreshBool(m.TextFormat.Italic, format.TextFormat.Italic)
+	m.TextFormat.Strikethrough = RefreshBool(m.TextFormat.Strikethrough, format.TextFormat.Strikethrough)
+	m.TextFormat.Underline = RefreshBool(m.TextFormat.Underline, format.TextFormat.Underline)
+	return m
+}
+
+func (m *InterpolationPointModel) ToInterpolationPoint() *sheets.InterpolationPoint {
+	if m == nil {
+		return nil
+	}
+	return &sheets.InterpolationPoint{
+		Color: ColorFromValue(m.Color),
+		Type:  m.Type.ValueString(),
+		Value: m.Value.ValueString(),
+	}
+}
+
+// Refresh updates the model with the interpolation point returned by the API.
+func (m *InterpolationPointModel) Refresh(point *sheets.InterpolationPoint) *InterpolationPointModel {
+	if point == nil {
+		return nil
+	}
+	if m == nil {
+		m = &InterpolationPointModel{}
+	}
+	m.Color = RefreshColor(m.Color, point.Color)
+	m.Type = types.StringValue(point.Type)
+	m.Value = RefreshString(m.Value, point.Value)
+	return m
+}
+
+// ToConditionalFormatRule builds the API representation without the ranges.
+func (m ConditionalFormatRuleResourceModel) ToConditionalFormatRule() *sheets.ConditionalFormatRule {
+	rule := &sheets.ConditionalFormatRule{}
+	if m.BooleanRule != nil {
+		rule.BooleanRule = &sheets.BooleanRule{
+			Condition: m.BooleanRule.Condition.ToBooleanCondition(),
+			Format:    m.BooleanRule.Format.ToCellFormat(),
+		}
+	}
+	if m.GradientRule != nil {
+		rule.GradientRule = &sheets.GradientRule{
+			Minpoint: m.GradientRule.Minpoint.ToInterpolationPoint(),
+			Midpoint: m.GradientRule.Midpoint.ToInterpolationPoint(),
+			Maxpoint: m.GradientRule.Maxpoint.ToInterpolationPoint(),
+		}
+	}
+	return rule
+}
+
+// GridRanges resolves the ranges of the rule. All of them must be in the same sheet.
+func (m ConditionalFormatRuleResourceModel) GridRanges(spreadsheet *sheets.Spreadsheet) ([]*sheets.GridRange, error) {
+	gridRanges := []*sheets.GridRange{}
+	for _, a1 := range m.Ranges {
+		gridRange, err := GridRangeFromA1(spreadsheet, a1.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		if len(gridRanges) > 0 && gridRanges[0].SheetId != gridRange.SheetId {
+			return nil, fmt.Errorf("all the ranges of a conditional format rule must be in the same sheet, %s is not", a1.ValueString())
+		}
+		gridRanges = append(gridRanges, gridRange)
+	}
+	return gridRanges, nil
+}
+
+// Refresh updates the model with the rule returned by the API.
+func (m *ConditionalFormatRuleResourceModel) Refresh(spreadsheet *sheets.Spreadsheet, rule *sheets.ConditionalFormatRule) error {
+	ranges := []types.String{}
+	for i, gridRange := range rule.Ranges {
+		current := types.StringNull()
+		if i < len(m.Ranges) {
+			current = m.Ranges[i]
+		}
+		refreshed, err := RefreshA1(spreadsheet, current, gridRange)
+		if err != nil {
+			return err
+		}
+		ranges = append(ranges, refreshed)
+	}
+	m.Ranges = ranges
+
+	if rule.BooleanRule == nil {
+		m.BooleanRule = nil
+	} else {
+		if m.BooleanRule == nil {
+			m.BooleanRule = &BooleanRuleModel{}
+		}
+		if condition := NewBooleanConditionModel(rule.BooleanRule.Condition); !condition.Equal(m.BooleanRule.Condition) {
+			m.BooleanRule.Condition = condition
+		}
+		m.BooleanRule.Format = m.BooleanRule.Format.Refresh(rule.BooleanRule.Format)
+	}
+
+	if rule.GradientRule == nil {
+		m.GradientRule = nil
+	} else {
+		if m.GradientRule == nil {
+			m.GradientRule = &GradientRuleModel{}
+		}
+		m.GradientRule.Minpoint = m.GradientRule.Minpoint.Refresh(rule.GradientRule.Minpoint)
+		m.GradientRule.Midpoint = m.GradientRule.Midpoint.Refresh(rule.GradientRule.Midpoint)
+		m.GradientRule.Maxpoint = m.GradientRule.Maxpoint.Refresh(rule.GradientRule.Maxpoint)
+	}
+	return nil
+}
+
+// conditionalFormatRuleKey identifies a rule by its content, only considering the fields managed by the provider.
+func conditionalFormatRuleKey(rule *sheets.ConditionalFormatRule) string {
+	model := ConditionalFormatRuleResourceModel{}
+	// Ranges are compared as grid ranges, so there is no need to resolve the sheet titles.
+	_ = model.Refresh(&sheets.Spreadsheet{}, &sheets.ConditionalFormatRule{
+		BooleanRule:  rule.BooleanRule,
+		GradientRule: rule.GradientRule,
+	})
+
+	normalized := model.ToConditionalFormatRule()
+	for _, gridRange := range rule.Ranges {
+		normalized.Ranges = append(normalized.Ranges, &sheets.GridRange{
+			SheetId:          gridRange.SheetId,
+			StartRowIndex:    gridRange.StartRowIndex,
+			EndRowIndex:      gridRange.EndRowIndex,
+			StartColumnIndex: gridRange.StartColumnIndex,
+			EndColumnIndex:   gridRange.EndColumnIndex,
+		})
+	}
+
+	key, _ := json.Marshal(normalized)
+	return string(key)
+}
+
+// FindConditionalFormatRule returns the index of the rule in the list.
+// Indexes shift when other rules are added or deleted, so rules are found by content.
+// If several rules are equal, the one closer to the given index wins. It returns -1 if it is not found.
+func FindConditionalFormatRule(rules []*sheets.ConditionalFormatRule, rule *sheets.ConditionalFormatRule, index int64) int64 {
+	key := conditionalFormatRuleKey(rule)
+	found := int64(-1)
+	for i, candidate := range rules {
+		if conditionalFormatRuleKey(candidate) != key {
+			continue
+		}
+		if found == -1 || abs(int64(i)-index) < abs(found-index) {
+			found = int64(i)
+		}
+	}
+	return found
+}
+
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func (r *ConditionalFormatRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_conditional_format_rule"
+}
+
+func (r *ConditionalFormatRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	interpolationPoint := func(description string, required bool) schema.SingleNestedAttribute {
+		return schema.SingleNestedAttribute{
+			MarkdownDescription: description,
+			Required:            required,
+			Optional:            !required,
+			Attributes: map[string]schema.Attribute{
+				"color": schema.StringAttribute{
+					MarkdownDescription: "The color in #RRGGBB notation",
+					Required:            true,
+					Validators: []validator.String{
+						hexColorValidator(),
+					},
+				},
+				"type": schema.StringAttribute{
+					MarkdownDescription: "How the value is interpreted. One of `MIN`, `MAX`, `NUMBER`, `PERCENT` or `PERCENTILE`",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("MIN", "MAX", "NUMBER", "PERCENT", "PERCENTILE"),
+					},
+				},
+				"value": schema.StringAttribute{
+					MarkdownDescription: "The value of the point. Not used with `MIN` and `MAX`",
+					Optional:            true,
+				},
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages a conditional formatting rule of a sheet.
+
+Rules are identified by their content, so other rules can be added or removed in the same sheet without affecting this one.
+New rules are added after the existing ones. If the rule is modified outside of terraform, it is no longer recognized and a new one is added.`,
+
+		Attributes: map[string]schema.Attribute{
+			"spreadsheet_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID for the spreadsheet. It can be obtained from the URL.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sheet_id": schema.Int64Attribute{
+				MarkdownDescription: "The sheet that contains the rule",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"index": schema.Int64Attribute{
+				MarkdownDescription: "The position of the rule in the list of rules of the sheet. Rules with a lower index are applied first.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"ranges": schema.ListAttribute{
+				MarkdownDescription: "The ranges formatted by the rule in A1 notation. All of them must be in the same sheet.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"boolean_rule": schema.SingleNestedAttribute{
+				MarkdownDescription: "Formats the cells that match the condition",
+				Optional:            true,
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(path.MatchRoot("gradient_rule")),
+				},
+				Attributes: map[string]schema.Attribute{
+					"condition": booleanConditionAttribute("The condition that cells must match. Use `CUSTOM_FORMULA` to evaluate a formula relative to the top left cell.", true),
+					"format": schema.SingleNestedAttribute{
+						MarkdownDescription: "The format applied to the matching cells",
+						Required:            true,
+						Attributes: map[string]schema.Attribute{
+							"background_color": schema.StringAttribute{
+								MarkdownDescription: "The background color in #RRGGBB notation",
+								Optional:            true,
+								Validators: []validator.String{
+									hexColorValidator(),
+								},
+							},
+							"text_format": schema.SingleNestedAttribute{
+								MarkdownDescription: "The text format",
+								Optional:            true,
+								Attributes: map[string]schema.Attribute{
+									"foreground_color": schema.StringAttribute{
+										MarkdownDescription: "The text color in #RRGGBB notation",
+										Optional:            true,
+										Validators: []validator.String{
+											hexColorValidator(),
+										},
+									},
+									"bold": schema.BoolAttribute{
+										Optional: true,
+									},
+									"italic": schema.BoolAttribute{
+										Optional: true,
+									},
+									"strikethrough": schema.BoolAttribute{
+										Optional: true,
+									},
+									"underline": schema.BoolAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"gradient_rule": schema.SingleNestedAttribute{
+				MarkdownDescription: "Colors the cells in a gradient based on their values",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"minpoint": interpolationPoint("The starting point of the gradient", true),
+					"midpoint": interpolationPoint("An optional midpoint of the gradient", false),
+					"maxpoint": interpolationPoint("The final point of the gradient", true),
+				},
+			},
+		},
+	}
+}
+
+// Configure implements resource.ResourceWithConfigure.
+func (r *ConditionalFormatRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sheets.Service)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Type",
+			fmt.Sprintf("Expected *sheets.Service, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create is called when the provider must create a new resource. Config
+// and planned state values should be read from the
+// CreateRequest and new state values set on the CreateResponse.
+func (r *ConditionalFormatRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ConditionalFormatRuleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spreadsheet, err := r.getSpreadsheet(ctx, data.SpreadsheetID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read spreadsheet", err.Error())
+		return
+	}
+
+	rule := data.ToConditionalFormatRule()
+	rule.Ranges, err = data.GridRanges(spreadsheet)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid range", err.Error())
+		return
+	}
+	sheet := FindSheetByID(spreadsheet, rule.Ranges[0].SheetId)
+
+	// Adding the rule at the end keeps the priority of the existing ones.
+	index := int64(len(sheet.ConditionalFormats))
+	createRequest := r.client.Spreadsheets.BatchUpdate(data.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{AddConditionalFormatRule: &sheets.AddConditionalFormatRuleRequest{
+				Rule:  rule,
+				Index: index,
+			}},
+		},
+	})
+	createRequest.Context(ctx)
+	_, err = createRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create conditional format rule", err.Error())
+		return
+	}
+
+	data.SheetID = basetypes.NewInt64Value(sheet.Properties.SheetId)
+	data.Index = basetypes.NewInt64Value(index)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (r *ConditionalFormatRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ":", 3)
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError("ID is not correct", "The ID must be a <spreadsheet_id>:<sheet_id>:<index>, but it was "+req.ID)
+		return
+	}
+
+	sheetID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("ID is not correct", "The sheet ID must be a number, but it was "+parts[1])
+		return
+	}
+	index, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("ID is not correct", "The index must be a number, but it was "+parts[2])
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("spreadsheet_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sheet_id"), sheetID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("index"), index)...)
+}
+
+// Read is called when the provider must read resource values in order
+// to update state. Planned state values should be read from the
+// ReadRequest and new state values set on the ReadResponse.
+func (r *ConditionalFormatRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ConditionalFormatRuleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spreadsheet, err := r.getSpreadsheet(ctx, data.SpreadsheetID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read data,", err.Error())
+		return
+	}
+
+	sheet := FindSheetByID(spreadsheet, data.SheetID.ValueInt64())
+	if sheet == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	index := int64(-1)
+	if len(data.Ranges) > 0 {
+		index, err = r.findRule(spreadsheet, sheet, &data)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid range", err.Error())
+			return
+		}
+	} else if data.Index.ValueInt64() < int64(len(sheet.ConditionalFormats)) {
+		// Imported rules are only known by their position.
+		index = data.Index.ValueInt64()
+	}
+	// A rule modified outside of terraform can't be told apart from the rules of other resources, so it is added again.
+	if index == -1 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	err = data.Refresh(spreadsheet, sheet.ConditionalFormats[index])
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read conditional format rule", err.Error())
+		return
+	}
+	data.Index = basetypes.NewInt64Value(index)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// New ranges may move the rule to another sheet, at the end of its rules, so the sheet and the index are only known after apply.
+func (r *ConditionalFormatRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var stateRanges, planRanges types.List
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("ranges"), &stateRanges)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("ranges"), &planRanges)...)
+
+	if resp.Diagnostics.HasError() || stateRanges.Equal(planRanges) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("sheet_id"), types.Int64Unknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("index"), types.Int64Unknown())...)
+}
+
+// Update is called to update the state of the resource. Config, planned
+// state, and prior state values should be read from the
+// UpdateRequest and new state values set on the UpdateResponse.
+func (r *ConditionalFormatRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var stateData ConditionalFormatRuleResourceModel
+	var planData ConditionalFormatRuleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spreadsheet, err := r.getSpreadsheet(ctx, stateData.SpreadsheetID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read spreadsheet", err.Error())
+		return
+	}
+
+	sheet := FindSheetByID(spreadsheet, stateData.SheetID.ValueInt64())
+	if sheet == nil {
+		resp.Diagnostics.AddError("Unable to find sheet", fmt.Sprintf("sheet %d was not found", stateData.SheetID.ValueInt64()))
+		return
+	}
+
+	index, err := r.findRule(spreadsheet, sheet, &stateData)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid range", err.Error())
+		return
+	}
+	if index == -1 {
+		// The index of the state may point to another rule by now, it must not be overwritten.
+		resp.Diagnostics.AddError("Unable to find conditional format rule", fmt.Sprintf("the rule was not found in sheet %d, refresh the state to add it again", sheet.Properties.SheetId))
+		return
+	}
+
+	rule := planData.ToConditionalFormatRule()
+	rule.Ranges, err = planData.GridRanges(spreadsheet)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid range", err.Error())
+		return
+	}
+
+	requests := []*sheets.Request{}
+	if rule.Ranges[0].SheetId == sheet.Properties.SheetId {
+		requests = append(requests, &sheets.Request{
+			UpdateConditionalFormatRule: &sheets.UpdateConditionalFormatRuleRequest{
+				SheetId: sheet.Properties.SheetId,
+				Index:   index,
+				Rule:    rule,
+			},
+		})
+	} else {
+		// Rules can't be moved between sheets, it is deleted and added at the end of the new one.
+		newSheet := FindSheetByID(spreadsheet, rule.Ranges[0].SheetId)
+		requests = append(requests,
+			&sheets.Request{
+				DeleteConditionalFormatRule: &sheets.DeleteConditionalFormatRuleRequest{
+					SheetId: sheet.Properties.SheetId,
+					Index:   index,
+				},
+			},
+			&sheets.Request{
+				AddConditionalFormatRule: &sheets.AddConditionalFormatRuleRequest{
+					Rule:  rule,
+					Index: int64(len(newSheet.ConditionalFormats)),
+				},
+			},
+		)
+		index = int64(len(newSheet.ConditionalFormats))
+	}
+
+	updateRequest := r.client.Spreadsheets.BatchUpdate(stateData.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: requests,
+	})
+	updateRequest.Context(ctx)
+	_, err = updateRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to perform update request", err.Error())
+		return
+	}
+
+	planData.SheetID = basetypes.NewInt64Value(rule.Ranges[0].SheetId)
+	planData.Index = basetypes.NewInt64Value(index)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+}
+
+// Delete is called when the provider must delete the resource. Config
+// values may be read from the DeleteRequest.
+//
+// If execution completes without error, the framework will automatically
+// call DeleteResponse.State.RemoveResource(), so it can be omitted
+// from provider logic.
+func (r *ConditionalFormatRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ConditionalFormatRuleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spreadsheet, err := r.getSpreadsheet(ctx, data.SpreadsheetID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read spreadsheet", err.Error())
+		return
+	}
+
+	sheet := FindSheetByID(spreadsheet, data.SheetID.ValueInt64())
+	if sheet == nil {
+		return
+	}
+
+	index, err := r.findRule(spreadsheet, sheet, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid range", err.Error())
+		return
+	}
+	if index == -1 {
+		// Other rules may have been deleted in the meantime, there is nothing to delete.
+		return
+	}
+
+	deleteRequest := r.client.Spreadsheets.BatchUpdate(data.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{DeleteConditionalFormatRule: &sheets.DeleteConditionalFormatRuleRequest{
+				SheetId: sheet.Properties.SheetId,
+				Index:   index,
+			}},
+		},
+	})
+	deleteRequest.Context(ctx)
+	_, err = deleteRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to delete conditional format rule", err.Error())
+		return
+	}
+}
+
+func (r *ConditionalFormatRuleResource) getSpreadsheet(ctx context.Context, spreadsheetID string) (*sheets.Spreadsheet, error) {
+	getRequest := r.client.Spreadsheets.Get(spreadsheetID)
+	getRequest.Fields("spreadsheetId,sheets(properties,conditionalFormats)")
+	getRequest.Context(ctx)
+	return getRequest.Do()
+}
+
+// findRule returns the current index of the rule described by data, -1 if it is not found.
+func (r *ConditionalFormatRuleResource) findRule(spreadsheet *sheets.Spreadsheet, sheet *sheets.Sheet, data *ConditionalFormatRuleResourceModel) (int64, error) {
+	rule := data.ToConditionalFormatRule()
+	gridRanges, err := data.GridRanges(spreadsheet)
+	if err != nil {
+		return -1, err
+	}
+	rule.Ranges = gridRanges
+	return FindConditionalFormatRule(sheet.ConditionalFormats, rule, data.Index.ValueInt64()), nil
+}
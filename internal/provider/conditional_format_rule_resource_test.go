@@ -0,0 +1,420 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestAccConditionalFormatRuleResource(t *testing.T) {
+	var lock sync.Mutex
+	var rules []*sheets.ConditionalFormatRule
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v4/spreadsheets/{spreadsheetId}", func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		res := sheets.Spreadsheet{
+			SpreadsheetId: r.PathValue("spreadsheetId"),
+			Sheets: []*sheets.Sheet{
+				{
+					Properties:         &sheets.SheetProperties{SheetId: 2, Title: "test title"},
+					ConditionalFormats: rules,
+				},
+			},
+		}
+		err := json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+	mux.HandleFunc("POST /v4/spreadsheets/{spreadsheetIdUpdate}", func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		defer r.Body.Close()
+		requestBody := &sheets.BatchUpdateSpreadsheetRequest{}
+		err := json.NewDecoder(r.Body).Decode(requestBody)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		for _, request := range requestBody.Requests {
+			switch {
+			case request.AddConditionalFormatRule != nil:
+				index := min(int(request.AddConditionalFormatRule.Index), len(rules))
+				rules = slices.Insert(rules, index, request.AddConditionalFormatRule.Rule)
+			case request.UpdateConditionalFormatRule != nil:
+				rules[request.UpdateConditionalFormatRule.Index] = request.UpdateConditionalFormatRule.Rule
+			case request.DeleteConditionalFormatRule != nil:
+				rules = slices.Delete(rules, int(request.DeleteConditionalFormatRule.Index), int(request.DeleteConditionalFormatRule.Index)+1)
+			}
+		}
+
+		res := sheets.BatchUpdateSpreadsheetResponse{
+			SpreadsheetId: strings.Split(r.PathValue("spreadsheetIdUpdate"), ":")[0],
+		}
+		err = json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	humanRule := &sheets.ConditionalFormatRule{
+		Ranges: []*sheets.GridRange{{SheetId: 2, StartColumnIndex: 5, EndColumnIndex: 6}},
+		BooleanRule: &sheets.BooleanRule{
+			Condition: &sheets.BooleanCondition{Type: "NOT_BLANK"},
+			Format:    &sheets.CellFormat{TextFormat: &sheets.TextFormat{Bold: true}},
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_conditional_format_rule" "expired" {
+	spreadsheet_id = "test-spreadsheet-id"
+	ranges = ["'test title'!A2:C"]
+	boolean_rule = {
+		condition = {
+			type = "CUSTOM_FORMULA"
+			values = ["=$C2<TODAY()"]
+		}
+		format = {
+			background_color = "#f4cccc"
+		}
+	}
+}
+
+resource "gsheets_conditional_format_rule" "score" {
+	spreadsheet_id = "test-spreadsheet-id"
+	ranges = ["'test title'!D2:D"]
+	# Keep the creation order stable so the imported index is predictable.
+	depends_on = [gsheets_conditional_format_rule.expired]
+	gradient_rule = {
+		minpoint = {
+			color = "#FFFFFF"
+			type = "MIN"
+		}
+		maxpoint = {
+			color = "#57BB8A"
+			type = "MAX"
+		}
+	}
+}
+	`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_conditional_format_rule.expired", "sheet_id", "2"),
+					resource.TestCheckResourceAttr("gsheets_conditional_format_rule.expired", "boolean_rule.format.background_color", "#f4cccc"),
+					resource.TestCheckNoResourceAttr("gsheets_conditional_format_rule.expired", "boolean_rule.format.text_format"),
+					resource.TestCheckResourceAttr("gsheets_conditional_format_rule.score", "gradient_rule.maxpoint.type", "MAX"),
+					resource.TestCheckNoResourceAttr("gsheets_conditional_format_rule.score", "gradient_rule.midpoint"),
+					func(s *terraform.State) error {
+						if len(rules) != 2 {
+							return fmt.Errorf("Expected 2 rules, got %d", len(rules))
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// A rule added by hand shifts the indexes of the managed ones.
+				PreConfig: func() {
+					rules = slices.Insert(rules, 0, humanRule)
+				},
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_conditional_format_rule" "expired" {
+	spreadsheet_id = "test-spreadsheet-id"
+	ranges = ["'test title'!A2:C"]
+	boolean_rule = {
+		condition = {
+			type = "CUSTOM_FORMULA"
+			values = ["=$C2<TODAY()"]
+		}
+		format = {
+			background_color = "#EA9999"
+			text_format = {
+				bold = true
+			}
+		}
+	}
+}
+
+resource "gsheets_conditional_format_rule" "score" {
+	spreadsheet_id = "test-spreadsheet-id"
+	ranges = ["'test title'!D2:D"]
+	# Keep the creation order stable so the imported index is predictable.
+	depends_on = [gsheets_conditional_format_rule.expired]
+	gradient_rule = {
+		minpoint = {
+			color = "#FFFFFF"
+			type = "MIN"
+		}
+		maxpoint = {
+			color = "#57BB8A"
+			type = "MAX"
+		}
+	}
+}
+	`, server.URL),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					// Only the format changes, the position of the rule is known before apply.
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue("gsheets_conditional_format_rule.expired", tfjsonpath.New("sheet_id"), knownvalue.Int64Exact(2)),
+						plancheck.ExpectKnownValue("gsheets_conditional_format_rule.expired", tfjsonpath.New("index"), knownvalue.Int64Exact(1)),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_conditional_format_rule.expired", "boolean_rule.format.background_color", "#EA9999"),
+					resource.TestCheckResourceAttr("gsheets_conditional_format_rule.expired", "boolean_rule.format.text_format.bold", "true"),
+					func(s *terraform.State) error {
+						if len(rules) != 3 {
+							return fmt.Errorf("Expected 3 rules, got %d", len(rules))
+						}
+						if rules[0] != humanRule {
+							return fmt.Errorf("Expected the rule added by hand to be untouched")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_conditional_format_rule" "expired" {
+	spreadsheet_id = "test-spreadsheet-id"
+	ranges = ["'test title'!A2:C"]
+	boolean_rule = {
+		condition = {
+			type = "CUSTOM_FORMULA"
+			values = ["=$C2<TODAY()"]
+		}
+		format = {
+			background_color = "#EA9999"
+			text_format = {
+				bold = true
+			}
+		}
+	}
+}
+	`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						if len(rules) != 2 {
+							return fmt.Errorf("Expected 2 rules, got %d", len(rules))
+						}
+						if rules[0] != humanRule || rules[1].GradientRule != nil {
+							return fmt.Errorf("Expected the gradient rule to be deleted")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:                         "gsheets_conditional_format_rule.expired",
+				ImportState:                          true,
+				ImportStateId:                        "test-spreadsheet-id:2:1",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "index",
+			},
+		},
+	})
+}
+
+func TestAccConditionalFormatRuleResource_ChangedOutside(t *testing.T) {
+	var lock sync.Mutex
+	var rules []*sheets.ConditionalFormatRule
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v4/spreadsheets/{spreadsheetId}", func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		res := sheets.Spreadsheet{
+			SpreadsheetId: r.PathValue("spreadsheetId"),
+			Sheets: []*sheets.Sheet{
+				{
+					Properties:         &sheets.SheetProperties{SheetId: 2, Title: "test title"},
+					ConditionalFormats: rules,
+				},
+			},
+		}
+		err := json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+	mux.HandleFunc("POST /v4/spreadsheets/{spreadsheetIdUpdate}", func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		defer r.Body.Close()
+		requestBody := &sheets.BatchUpdateSpreadsheetRequest{}
+		err := json.NewDecoder(r.Body).Decode(requestBody)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		for _, request := range requestBody.Requests {
+			switch {
+			case request.AddConditionalFormatRule != nil:
+				index := min(int(request.AddConditionalFormatRule.Index), len(rules))
+				rules = slices.Insert(rules, index, request.AddConditionalFormatRule.Rule)
+			case request.UpdateConditionalFormatRule != nil:
+				rules[request.UpdateConditionalFormatRule.Index] = request.UpdateConditionalFormatRule.Rule
+			case request.DeleteConditionalFormatRule != nil:
+				rules = slices.Delete(rules, int(request.DeleteConditionalFormatRule.Index), int(request.DeleteConditionalFormatRule.Index)+1)
+			}
+		}
+
+		res := sheets.BatchUpdateSpreadsheetResponse{
+			SpreadsheetId: strings.Split(r.PathValue("spreadsheetIdUpdate"), ":")[0],
+		}
+		err = json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	config := fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_conditional_format_rule" "expired" {
+	spreadsheet_id = "test-spreadsheet-id"
+	ranges = ["'test title'!A2:C"]
+	boolean_rule = {
+		condition = {
+			type = "CUSTOM_FORMULA"
+			values = ["=$C2<TODAY()"]
+		}
+		format = {
+			background_color = "#f4cccc"
+		}
+	}
+}
+
+resource "gsheets_conditional_format_rule" "score" {
+	spreadsheet_id = "test-spreadsheet-id"
+	ranges = ["'test title'!D2:D"]
+	depends_on = [gsheets_conditional_format_rule.expired]
+	gradient_rule = {
+		minpoint = {
+			color = "#FFFFFF"
+			type = "MIN"
+		}
+		maxpoint = {
+			color = "#57BB8A"
+			type = "MAX"
+		}
+	}
+}
+`, server.URL)
+
+	isScore := func(rule *sheets.ConditionalFormatRule) bool {
+		return rule.GradientRule != nil && rule.GradientRule.Maxpoint.Color.Green > 0.7
+	}
+	isExpired := func(rule *sheets.ConditionalFormatRule) bool {
+		return rule.BooleanRule != nil && rule.BooleanRule.Format.BackgroundColor.Red > 0.9
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: func(s *terraform.State) error {
+					if len(rules) != 2 || !isExpired(rules[0]) || !isScore(rules[1]) {
+						return fmt.Errorf("Unexpected rules %v", rules)
+					}
+					return nil
+				},
+			},
+			{
+				// The first rule was deleted by hand, the other one takes its index but it must not be taken over.
+				PreConfig: func() {
+					rules = slices.Delete(rules, 0, 1)
+				},
+				Config: config,
+				Check: func(s *terraform.State) error {
+					if len(rules) != 2 || !isScore(rules[0]) || !isExpired(rules[1]) {
+						return fmt.Errorf("Expected the score rule to be untouched and the expired rule to be added again, got %v", rules)
+					}
+					return nil
+				},
+			},
+			{
+				// The score rule was edited by hand, it is no longer recognized and it is added again without touching the other rules.
+				PreConfig: func() {
+					rules[0] = &sheets.ConditionalFormatRule{
+						Ranges: rules[0].Ranges,
+						GradientRule: &sheets.GradientRule{
+							Minpoint: rules[0].GradientRule.Minpoint,
+							Maxpoint: &sheets.InterpolationPoint{Color: &sheets.Color{Red: 1}, Type: "MAX"},
+						},
+					}
+				},
+				Config: config,
+				Check: func(s *terraform.State) error {
+					if len(rules) != 3 || isScore(rules[0]) || !isExpired(rules[1]) || !isScore(rules[2]) {
+						return fmt.Errorf("Expected the edited rule to be left alone, got %v", rules)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
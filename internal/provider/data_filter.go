@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/api/sheets/v4"
+)
+
+// DataFilterModel selects the cells tagged with developer metadata.
+type DataFilterModel struct {
+	MetadataID    types.Int64  `tfsdk:"metadata_id"`
+	MetadataKey   types.String `tfsdk:"metadata_key"`
+	MetadataValue types.String `tfsdk:"metadata_value"`
+}
+
+func (m *DataFilterModel) ToDataFilter() *sheets.DataFilter {
+	return &sheets.DataFilter{
+		DeveloperMetadataLookup: &sheets.DeveloperMetadataLookup{
+			MetadataId:    m.MetadataID.ValueInt64(),
+			MetadataKey:   m.MetadataKey.ValueString(),
+			MetadataValue: m.MetadataValue.ValueString(),
+		},
+	}
+}
+
+// MatchedValueRange returns the values of the only range matched by the data filter.
+func MatchedValueRange(response *sheets.BatchGetValuesByDataFilterResponse) (*sheets.ValueRange, error) {
+	switch len(response.ValueRanges) {
+	case 0:
+		return nil, fmt.Errorf("no range matches the data filter")
+	case 1:
+		return response.ValueRanges[0].ValueRange, nil
+	default:
+		return nil, fmt.Errorf("the data filter matches %d ranges, it must match only one", len(response.ValueRanges))
+	}
+}
+
+// dataFilterAttribute is shared by all the resources that can locate cells by developer metadata.
+func dataFilterAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Validators: []validator.Object{
+			objectvalidator.ExactlyOneOf(path.MatchRoot("range")),
+		},
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.RequiresReplace(),
+		},
+		Attributes: map[string]schema.Attribute{
+			"metadata_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the developer metadata",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("metadata_key")),
+				},
+			},
+			"metadata_key": schema.StringAttribute{
+				MarkdownDescription: "The key of the developer metadata",
+				Optional:            true,
+			},
+			"metadata_value": schema.StringAttribute{
+				MarkdownDescription: "The value of the developer metadata. It is only used along with the key",
+				Optional:            true,
+			},
+		},
+	}
+}
@@ -0,0 +1,315 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"google.golang.org/api/sheets/v4"
+)
+
+var _ resource.ResourceWithConfigure = &DataValidationResource{}
+var _ resource.ResourceWithImportState = &DataValidationResource{}
+
+func NewDataValidationResource() resource.Resource {
+	return &DataValidationResource{}
+}
+
+type DataValidationResource struct {
+	client *sheets.Service
+}
+
+type DataValidationResourceModel struct {
+	SpreadsheetID types.String           `tfsdk:"spreadsheet_id"`
+	Range         types.String           `tfsdk:"range"`
+	Condition     *BooleanConditionModel `tfsdk:"condition"`
+	Strict        types.Bool             `tfsdk:"strict"`
+	ShowCustomUI  types.Bool             `tfsdk:"show_custom_ui"`
+	InputMessage  types.String           `tfsdk:"input_message"`
+}
+
+func (m DataValidationResourceModel) ToDataValidationRule() *sheets.DataValidationRule {
+	return &sheets.DataValidationRule{
+		Condition:    m.Condition.ToBooleanCondition(),
+		Strict:       m.Strict.ValueBool(),
+		ShowCustomUi: m.ShowCustomUI.ValueBool(),
+		InputMessage: m.InputMessage.ValueString(),
+	}
+}
+
+// Refresh updates the model with the rule returned by the API.
+func (m *DataValidationResourceModel) Refresh(rule *sheets.DataValidationRule) {
+	if condition := NewBooleanConditionModel(rule.Condition); !condition.Equal(m.Condition) {
+		m.Condition = condition
+	}
+	m.Strict = types.BoolValue(rule.Strict)
+	m.ShowCustomUI = types.BoolValue(rule.ShowCustomUi)
+	if !m.InputMessage.IsNull() || rule.InputMessage != "" {
+		m.InputMessage = types.StringValue(rule.InputMessage)
+	}
+}
+
+func (r *DataValidationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_validation"
+}
+
+func (r *DataValidationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Sets a data validation rule on every cell of a range, such as dropdowns, checkboxes or number ranges.
+
+If any cell of the range ends up with a different rule, the rule is applied again to the whole range.`,
+
+		Attributes: map[string]schema.Attribute{
+			"spreadsheet_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID for the spreadsheet. It can be obtained from the URL.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"range": schema.StringAttribute{
+				MarkdownDescription: "The range to validate in A1 notation. Use the sheet title to point to a specific sheet.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"condition": booleanConditionAttribute("The condition that data in the cells must match. Use `ONE_OF_LIST` for dropdowns and `BOOLEAN` for checkboxes.", true),
+			"strict": schema.BoolAttribute{
+				MarkdownDescription: "True if invalid data should be rejected",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"show_custom_ui": schema.BoolAttribute{
+				MarkdownDescription: "True if the UI should be customized based on the kind of condition. For example, dropdowns for `ONE_OF_LIST`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"input_message": schema.StringAttribute{
+				MarkdownDescription: "A message to show the user when adding data to the cells",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// Configure implements resource.ResourceWithConfigure.
+func (r *DataValidationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sheets.Service)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Type",
+			fmt.Sprintf("Expected *sheets.Service, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create is called when the provider must create a new resource. Config
+// and planned state values should be read from the
+// CreateRequest and new state values set on the CreateResponse.
+func (r *DataValidationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DataValidationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.setDataValidation(ctx, &data, data.ToDataValidationRule())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to set data validation", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (r *DataValidationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ":", 2)
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError("ID is not correct", "The ID must be a <spreadsheet_id>:<range>, but it was "+req.ID)
+		return
+	}
+
+	data := DataValidationResourceModel{
+		SpreadsheetID: basetypes.NewStringValue(parts[0]),
+		Range:         basetypes.NewStringValue(parts[1]),
+		InputMessage:  basetypes.NewStringNull(),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read is called when the provider must read resource values in order
+// to update state. Planned state values should be read from the
+// ReadRequest and new state values set on the ReadResponse.
+func (r *DataValidationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DataValidationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The sheet is resolved first, requesting the grid data of a deleted sheet fails.
+	propertiesRequest := r.client.Spreadsheets.Get(data.SpreadsheetID.ValueString())
+	propertiesRequest.Fields("spreadsheetId,sheets.properties")
+	propertiesRequest.Context(ctx)
+	properties, err := propertiesRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read data,", err.Error())
+		return
+	}
+
+	_, err = GridRangeFromA1(properties, data.Range.ValueString())
+	var sheetNotFound *SheetNotFoundError
+	if errors.As(err, &sheetNotFound) {
+		// The rules were deleted along with the sheet.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid range", err.Error())
+		return
+	}
+
+	getRequest := r.client.Spreadsheets.Get(data.SpreadsheetID.ValueString())
+	getRequest.Ranges(data.Range.ValueString())
+	getRequest.IncludeGridData(true)
+	getRequest.Fields("spreadsheetId,sheets(properties,data(startRow,startColumn,rowData.values.dataValidation))")
+	getRequest.Context(ctx)
+	spreadsheet, err := getRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read data,", err.Error())
+		return
+	}
+
+	gridRange, err := GridRangeFromA1(spreadsheet, data.Range.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid range", err.Error())
+		return
+	}
+
+	// The rule is only considered present if every cell has the same one.
+	var rule *sheets.DataValidationRule
+	uniform := true
+	first := true
+	ForEachCell(FindSheetByID(spreadsheet, gridRange.SheetId), gridRange, func(row, column int64, cell *sheets.CellData) bool {
+		var cellRule *sheets.DataValidationRule
+		if cell != nil {
+			cellRule = cell.DataValidation
+		}
+		if first {
+			rule = cellRule
+			first = false
+			return true
+		}
+		uniform = reflect.DeepEqual(rule, cellRule)
+		return uniform
+	})
+
+	if rule == nil || !uniform {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Refresh(rule)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is called to update the state of the resource. Config, planned
+// state, and prior state values should be read from the
+// UpdateRequest and new state values set on the UpdateResponse.
+func (r *DataValidationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DataValidationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.setDataValidation(ctx, &data, data.ToDataValidationRule())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to perform update request", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete is called when the provider must delete the resource. Config
+// values may be read from the DeleteRequest.
+//
+// If execution completes without error, the framework will automatically
+// call DeleteResponse.State.RemoveResource(), so it can be omitted
+// from provider logic.
+func (r *DataValidationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DataValidationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Setting an empty rule clears the validation of the range.
+	err := r.setDataValidation(ctx, &data, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to clear data validation", err.Error())
+		return
+	}
+}
+
+func (r *DataValidationResource) setDataValidation(ctx context.Context, data *DataValidationResourceModel, rule *sheets.DataValidationRule) error {
+	getRequest := r.client.Spreadsheets.Get(data.SpreadsheetID.ValueString())
+	getRequest.Fields("spreadsheetId,sheets.properties")
+	getRequest.Context(ctx)
+	spreadsheet, err := getRequest.Do()
+	if err != nil {
+		return err
+	}
+
+	gridRange, err := GridRangeFromA1(spreadsheet, data.Range.ValueString())
+	if err != nil {
+		return err
+	}
+
+	updateRequest := r.client.Spreadsheets.BatchUpdate(data.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{SetDataValidation: &sheets.SetDataValidationRequest{
+				Range: gridRange,
+				Rule:  rule,
+			}},
+		},
+	})
+	updateRequest.Context(ctx)
+	_, err = updateRequest.Do()
+	return err
+}
@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestAccDataValidationResource(t *testing.T) {
+	var validationRange *sheets.GridRange
+	var validationRule *sheets.DataValidationRule
+	humanEdited := false
+	sheetDeleted := false
+	setCalls := 0
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v4/spreadsheets/{spreadsheetId}", func(w http.ResponseWriter, r *http.Request) {
+		if sheetDeleted {
+			// Ranges of a deleted sheet can't be parsed.
+			if r.URL.Query().Has("ranges") {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			err := json.NewEncoder(w).Encode(sheets.Spreadsheet{SpreadsheetId: r.PathValue("spreadsheetId")})
+			if err != nil {
+				log.Println(err)
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+			return
+		}
+
+		sheet := &sheets.Sheet{
+			Properties: &sheets.SheetProperties{
+				SheetId:        2,
+				Title:          "test title",
+				GridProperties: &sheets.GridProperties{RowCount: 100, ColumnCount: 26},
+			},
+		}
+		if r.URL.Query().Get("includeGridData") == "true" && validationRange != nil {
+			data := &sheets.GridData{
+				StartRow:    validationRange.StartRowIndex,
+				StartColumn: validationRange.StartColumnIndex,
+			}
+			for row := validationRange.StartRowIndex; row < validationRange.EndRowIndex; row++ {
+				rowData := &sheets.RowData{}
+				for column := validationRange.StartColumnIndex; column < validationRange.EndColumnIndex; column++ {
+					rowData.Values = append(rowData.Values, &sheets.CellData{DataValidation: validationRule})
+				}
+				data.RowData = append(data.RowData, rowData)
+			}
+			if humanEdited {
+				data.RowData[0].Values[0] = &sheets.CellData{}
+			}
+			sheet.Data = []*sheets.GridData{data}
+		}
+
+		res := sheets.Spreadsheet{
+			SpreadsheetId: r.PathValue("spreadsheetId"),
+			Sheets:        []*sheets.Sheet{sheet},
+		}
+		err := json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+	mux.HandleFunc("POST /v4/spreadsheets/{spreadsheetIdUpdate}", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		requestBody := &sheets.BatchUpdateSpreadsheetRequest{}
+		err := json.NewDecoder(r.Body).Decode(requestBody)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		request := requestBody.Requests[0].SetDataValidation
+		if request == nil {
+			t.Errorf("Expected set data validation request")
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		setCalls++
+		validationRange = request.Range
+		validationRule = request.Rule
+		humanEdited = false
+
+		res := sheets.BatchUpdateSpreadsheetResponse{
+			SpreadsheetId: strings.Split(r.PathValue("spreadsheetIdUpdate"), ":")[0],
+			Replies:       []*sheets.Response{{}},
+		}
+		err = json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_data_validation" "role" {
+	spreadsheet_id = "test-spreadsheet-id"
+	range = "'test title'!B2:B4"
+	condition = {
+		type = "ONE_OF_LIST"
+		values = ["admin", "member"]
+	}
+	strict = true
+	show_custom_ui = true
+}
+	`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_data_validation.role", "condition.type", "ONE_OF_LIST"),
+					resource.TestCheckResourceAttr("gsheets_data_validation.role", "condition.values.#", "2"),
+					resource.TestCheckResourceAttr("gsheets_data_validation.role", "strict", "true"),
+					resource.TestCheckResourceAttr("gsheets_data_validation.role", "show_custom_ui", "true"),
+					resource.TestCheckNoResourceAttr("gsheets_data_validation.role", "input_message"),
+				),
+			},
+			{
+				// One cell lost its validation, the rule must be applied again.
+				PreConfig: func() {
+					humanEdited = true
+					setCalls = 0
+				},
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_data_validation" "role" {
+	spreadsheet_id = "test-spreadsheet-id"
+	range = "'test title'!B2:B4"
+	condition = {
+		type = "ONE_OF_LIST"
+		values = ["admin", "member"]
+	}
+	strict = true
+	show_custom_ui = true
+}
+	`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_data_validation.role", "condition.type", "ONE_OF_LIST"),
+					func(s *terraform.State) error {
+						if setCalls != 1 {
+							return fmt.Errorf("Expected the rule to be set again, got %d calls", setCalls)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_data_validation" "role" {
+	spreadsheet_id = "test-spreadsheet-id"
+	range = "'test title'!B2:B4"
+	condition = {
+		type = "BOOLEAN"
+	}
+	input_message = "approved"
+}
+	`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_data_validation.role", "condition.type", "BOOLEAN"),
+					resource.TestCheckNoResourceAttr("gsheets_data_validation.role", "condition.values"),
+					resource.TestCheckResourceAttr("gsheets_data_validation.role", "strict", "false"),
+					resource.TestCheckResourceAttr("gsheets_data_validation.role", "input_message", "approved"),
+				),
+			},
+			{
+				ResourceName:                         "gsheets_data_validation.role",
+				ImportState:                          true,
+				ImportStateId:                        "test-spreadsheet-id:'test title'!B2:B4",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "range",
+			},
+			{
+				// The sheet was deleted by hand, along with its rules.
+				PreConfig: func() {
+					sheetDeleted = true
+					validationRange = nil
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+				Check: func(s *terraform.State) error {
+					if len(s.RootModule().Resources) != 0 {
+						return fmt.Errorf("Expected the rule to be removed from the state, got %v", s.RootModule().Resources)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
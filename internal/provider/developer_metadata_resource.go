@@ -0,0 +1,418 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"google.golang.org/api/sheets/v4"
+)
+
+var _ resource.ResourceWithConfigure = &DeveloperMetadataResource{}
+var _ resource.ResourceWithImportState = &DeveloperMetadataResource{}
+
+func NewDeveloperMetadataResource() resource.Resource {
+	return &DeveloperMetadataResource{}
+}
+
+type DeveloperMetadataResource struct {
+	client *sheets.Service
+}
+
+type DeveloperMetadataResourceModel struct {
+	SpreadsheetID types.String                    `tfsdk:"spreadsheet_id"`
+	MetadataID    types.Int64                     `tfsdk:"metadata_id"`
+	Key           types.String                    `tfsdk:"key"`
+	Value         types.String                    `tfsdk:"value"`
+	Visibility    types.String                    `tfsdk:"visibility"`
+	Location      *DeveloperMetadataLocationModel `tfsdk:"location"`
+}
+
+type DeveloperMetadataLocationModel struct {
+	Spreadsheet    types.Bool           `tfsdk:"spreadsheet"`
+	SheetID        types.Int64          `tfsdk:"sheet_id"`
+	DimensionRange *DimensionRangeModel `tfsdk:"dimension_range"`
+}
+
+type DimensionRangeModel struct {
+	SheetID    types.Int64  `tfsdk:"sheet_id"`
+	Dimension  types.String `tfsdk:"dimension"`
+	StartIndex types.Int64  `tfsdk:"start_index"`
+	EndIndex   types.Int64  `tfsdk:"end_index"`
+}
+
+func (m *DeveloperMetadataLocationModel) ToDeveloperMetadataLocation() *sheets.DeveloperMetadataLocation {
+	switch {
+	case m.DimensionRange != nil:
+		return &sheets.DeveloperMetadataLocation{
+			DimensionRange: &sheets.DimensionRange{
+				SheetId:    m.DimensionRange.SheetID.ValueInt64(),
+				Dimension:  m.DimensionRange.Dimension.ValueString(),
+				StartIndex: m.DimensionRange.StartIndex.ValueInt64(),
+				EndIndex:   m.DimensionRange.EndIndex.ValueInt64(),
+				// The first sheet and the first row have index 0, which would be omitted otherwise.
+				ForceSendFields: []string{"SheetId", "StartIndex"},
+			},
+		}
+	case !m.SheetID.IsNull():
+		return &sheets.DeveloperMetadataLocation{
+			SheetId:         m.SheetID.ValueInt64(),
+			ForceSendFields: []string{"SheetId"},
+		}
+	default:
+		return &sheets.DeveloperMetadataLocation{Spreadsheet: true}
+	}
+}
+
+// NewDeveloperMetadataLocationModel is the inverse of ToDeveloperMetadataLocation.
+func NewDeveloperMetadataLocationModel(location *sheets.DeveloperMetadataLocation) *DeveloperMetadataLocationModel {
+	m := &DeveloperMetadataLocationModel{
+		Spreadsheet: types.BoolNull(),
+		SheetID:     types.Int64Null(),
+	}
+	switch {
+	case location == nil:
+		return nil
+	case location.DimensionRange != nil:
+		m.DimensionRange = &DimensionRangeModel{
+			SheetID:    types.Int64Value(location.DimensionRange.SheetId),
+			Dimension:  types.StringValue(location.DimensionRange.Dimension),
+			StartIndex: types.Int64Value(location.DimensionRange.StartIndex),
+			EndIndex:   types.Int64Value(location.DimensionRange.EndIndex),
+		}
+	case location.Spreadsheet:
+		m.Spreadsheet = types.BoolValue(true)
+	default:
+		m.SheetID = types.Int64Value(location.SheetId)
+	}
+	return m
+}
+
+func (m DeveloperMetadataResourceModel) ToDeveloperMetadata() *sheets.DeveloperMetadata {
+	metadata := &sheets.DeveloperMetadata{
+		MetadataId:    m.MetadataID.ValueInt64(),
+		MetadataKey:   m.Key.ValueString(),
+		MetadataValue: m.Value.ValueString(),
+		Visibility:    m.Visibility.ValueString(),
+	}
+	if m.Location != nil {
+		metadata.Location = m.Location.ToDeveloperMetadataLocation()
+	}
+	return metadata
+}
+
+// Refresh updates the model with the metadata returned by the API.
+// Rows and columns metadata moves along with them, so the location is only read when it is unknown, like after an import.
+func (m *DeveloperMetadataResourceModel) Refresh(metadata *sheets.DeveloperMetadata) {
+	m.MetadataID = types.Int64Value(metadata.MetadataId)
+	m.Key = types.StringValue(metadata.MetadataKey)
+	m.Value = RefreshString(m.Value, metadata.MetadataValue)
+	m.Visibility = types.StringValue(metadata.Visibility)
+	if m.Location == nil {
+		m.Location = NewDeveloperMetadataLocationModel(metadata.Location)
+	}
+}
+
+// metadataIDFilter selects the developer metadata with the given id.
+func metadataIDFilter(id int64) *sheets.DataFilter {
+	return &sheets.DataFilter{
+		DeveloperMetadataLookup: &sheets.DeveloperMetadataLookup{
+			MetadataId: id,
+		},
+	}
+}
+
+func (r *DeveloperMetadataResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_developer_metadata"
+}
+
+func (r *DeveloperMetadataResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Tags the spreadsheet, a sheet, or some rows or columns with a key and a value.
+
+Metadata on rows or columns moves along with them when other rows or columns are inserted or deleted, so it can be used to find them with the data_filter of gsheets_range.`,
+
+		Attributes: map[string]schema.Attribute{
+			"spreadsheet_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID for the spreadsheet. It can be obtained from the URL.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"metadata_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the metadata. It is assigned by google sheets if not set. It must be unique in the spreadsheet.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The key of the metadata",
+				Required:            true,
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "The value of the metadata",
+				Optional:            true,
+			},
+			"visibility": schema.StringAttribute{
+				MarkdownDescription: "Either `DOCUMENT`, visible to anyone with access to the spreadsheet, or `PROJECT`, only visible to the project that created it. Defaults to `DOCUMENT`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("DOCUMENT"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("DOCUMENT", "PROJECT"),
+				},
+			},
+			"location": schema.SingleNestedAttribute{
+				MarkdownDescription: "What the metadata is associated with. Changing it creates a new metadata.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"spreadsheet": schema.BoolAttribute{
+						MarkdownDescription: "Associates the metadata with the whole spreadsheet. It must be `true` when set",
+						Optional:            true,
+						Validators: []validator.Bool{
+							boolvalidator.ExactlyOneOf(
+								path.MatchRelative().AtParent().AtName("sheet_id"),
+								path.MatchRelative().AtParent().AtName("dimension_range"),
+							),
+						},
+					},
+					"sheet_id": schema.Int64Attribute{
+						MarkdownDescription: "Associates the metadata with a sheet",
+						Optional:            true,
+					},
+					"dimension_range": schema.SingleNestedAttribute{
+						MarkdownDescription: "Associates the metadata with some rows or columns",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"sheet_id": schema.Int64Attribute{
+								MarkdownDescription: "The sheet of the rows or columns",
+								Required:            true,
+							},
+							"dimension": schema.StringAttribute{
+								MarkdownDescription: "Either `ROWS` or `COLUMNS`",
+								Required:            true,
+								Validators: []validator.String{
+									stringvalidator.OneOf("ROWS", "COLUMNS"),
+								},
+							},
+							"start_index": schema.Int64Attribute{
+								MarkdownDescription: "The first row or column, starting at 0",
+								Required:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(0),
+								},
+							},
+							"end_index": schema.Int64Attribute{
+								MarkdownDescription: "The row or column after the last one",
+								Required:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure implements resource.ResourceWithConfigure.
+func (r *DeveloperMetadataResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sheets.Service)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Type",
+			fmt.Sprintf("Expected *sheets.Service, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create is called when the provider must create a new resource. Config
+// and planned state values should be read from the
+// CreateRequest and new state values set on the CreateResponse.
+func (r *DeveloperMetadataResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DeveloperMetadataResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createRequest := r.client.Spreadsheets.BatchUpdate(data.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{CreateDeveloperMetadata: &sheets.CreateDeveloperMetadataRequest{
+				DeveloperMetadata: data.ToDeveloperMetadata(),
+			}},
+		},
+	})
+	createRequest.Context(ctx)
+	createResponse, err := createRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create developer metadata", err.Error())
+		return
+	}
+
+	data.MetadataID = types.Int64Value(createResponse.Replies[0].CreateDeveloperMetadata.DeveloperMetadata.MetadataId)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (r *DeveloperMetadataResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ":", 2)
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError("ID is not correct", "The ID must be a <spreadsheet_id>:<metadata_id>, but it was "+req.ID)
+		return
+	}
+
+	id, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("ID is not correct", "The metadata id must be a number, but it was "+parts[1])
+		return
+	}
+
+	data := DeveloperMetadataResourceModel{
+		SpreadsheetID: basetypes.NewStringValue(parts[0]),
+		MetadataID:    basetypes.NewInt64Value(id),
+		Key:           basetypes.NewStringNull(),
+		Value:         basetypes.NewStringNull(),
+		Visibility:    basetypes.NewStringNull(),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read is called when the provider must read resource values in order
+// to update state. Planned state values should be read from the
+// ReadRequest and new state values set on the ReadResponse.
+func (r *DeveloperMetadataResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DeveloperMetadataResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	searchRequest := r.client.Spreadsheets.DeveloperMetadata.Search(data.SpreadsheetID.ValueString(), &sheets.SearchDeveloperMetadataRequest{
+		DataFilters: []*sheets.DataFilter{metadataIDFilter(data.MetadataID.ValueInt64())},
+	})
+	searchRequest.Context(ctx)
+	searchResponse, err := searchRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read data,", err.Error())
+		return
+	}
+
+	if len(searchResponse.MatchedDeveloperMetadata) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Refresh(searchResponse.MatchedDeveloperMetadata[0].DeveloperMetadata)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is called to update the state of the resource. Config, planned
+// state, and prior state values should be read from the
+// UpdateRequest and new state values set on the UpdateResponse.
+func (r *DeveloperMetadataResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DeveloperMetadataResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metadata := data.ToDeveloperMetadata()
+	// The location is not updated, it would move the metadata back after rows are inserted.
+	metadata.Location = nil
+
+	updateRequest := r.client.Spreadsheets.BatchUpdate(data.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{UpdateDeveloperMetadata: &sheets.UpdateDeveloperMetadataRequest{
+				DataFilters:       []*sheets.DataFilter{metadataIDFilter(data.MetadataID.ValueInt64())},
+				DeveloperMetadata: metadata,
+				Fields:            "metadataKey,metadataValue,visibility",
+			}},
+		},
+	})
+	updateRequest.Context(ctx)
+	_, err := updateRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to perform update request", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete is called when the provider must delete the resource. Config
+// values may be read from the DeleteRequest.
+//
+// If execution completes without error, the framework will automatically
+// call DeleteResponse.State.RemoveResource(), so it can be omitted
+// from provider logic.
+func (r *DeveloperMetadataResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DeveloperMetadataResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteRequest := r.client.Spreadsheets.BatchUpdate(data.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{DeleteDeveloperMetadata: &sheets.DeleteDeveloperMetadataRequest{
+				DataFilter: metadataIDFilter(data.MetadataID.ValueInt64()),
+			}},
+		},
+	})
+	deleteRequest.Context(ctx)
+	_, err := deleteRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to delete developer metadata", err.Error())
+		return
+	}
+}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestAccDeveloperMetadataResource(t *testing.T) {
+	var metadata []*sheets.DeveloperMetadata
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v4/spreadsheets/{spreadsheetId}/developerMetadata:search", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		requestBody := &sheets.SearchDeveloperMetadataRequest{}
+		err := json.NewDecoder(r.Body).Decode(requestBody)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		res := sheets.SearchDeveloperMetadataResponse{}
+		for _, m := range metadata {
+			if m.MetadataId == requestBody.DataFilters[0].DeveloperMetadataLookup.MetadataId {
+				res.MatchedDeveloperMetadata = append(res.MatchedDeveloperMetadata, &sheets.MatchedDeveloperMetadata{DeveloperMetadata: m})
+			}
+		}
+		err = json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+	mux.HandleFunc("POST /v4/spreadsheets/{spreadsheetIdUpdate}", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		requestBody := &sheets.BatchUpdateSpreadsheetRequest{}
+		err := json.NewDecoder(r.Body).Decode(requestBody)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		res := sheets.BatchUpdateSpreadsheetResponse{
+			SpreadsheetId: strings.Split(r.PathValue("spreadsheetIdUpdate"), ":")[0],
+			Replies:       []*sheets.Response{{}},
+		}
+		request := requestBody.Requests[0]
+		switch {
+		case request.CreateDeveloperMetadata != nil:
+			created := request.CreateDeveloperMetadata.DeveloperMetadata
+			if created.MetadataId == 0 {
+				created.MetadataId = 1234
+			}
+			if created.Location.DimensionRange != nil {
+				created.Location.LocationType = "ROW"
+			}
+			metadata = append(metadata, created)
+			res.Replies[0].CreateDeveloperMetadata = &sheets.CreateDeveloperMetadataResponse{DeveloperMetadata: created}
+		case request.UpdateDeveloperMetadata != nil:
+			if request.UpdateDeveloperMetadata.DeveloperMetadata.Location != nil {
+				t.Errorf("The location must not be updated")
+			}
+			for _, m := range metadata {
+				if m.MetadataId == request.UpdateDeveloperMetadata.DataFilters[0].DeveloperMetadataLookup.MetadataId {
+					m.MetadataValue = request.UpdateDeveloperMetadata.DeveloperMetadata.MetadataValue
+				}
+			}
+		case request.DeleteDeveloperMetadata != nil:
+			metadata = nil
+		default:
+			t.Errorf("Unexpected request %v", request)
+		}
+
+		err = json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	config := func(value string) string {
+		return fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_developer_metadata" "test" {
+	spreadsheet_id = "test-spreadsheet-id"
+	key = "owner"
+	value = "%s"
+	location = {
+		dimension_range = {
+			sheet_id = 0
+			dimension = "ROWS"
+			start_index = 0
+			end_index = 10
+		}
+	}
+}
+`, server.URL, value)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		CheckDestroy: func(s *terraform.State) error {
+			if len(metadata) != 0 {
+				return fmt.Errorf("Expected the metadata to be deleted")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("terraform"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_developer_metadata.test", "metadata_id", "1234"),
+					resource.TestCheckResourceAttr("gsheets_developer_metadata.test", "visibility", "DOCUMENT"),
+					func(s *terraform.State) error {
+						location := metadata[0].Location.DimensionRange
+						if location.SheetId != 0 || location.StartIndex != 0 || location.EndIndex != 10 {
+							return fmt.Errorf("Unexpected location %v", location)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// Rows inserted above the tagged rows move the metadata, it must not be planned again.
+				PreConfig: func() {
+					metadata[0].Location.DimensionRange.StartIndex = 3
+					metadata[0].Location.DimensionRange.EndIndex = 13
+				},
+				Config:   config("terraform"),
+				PlanOnly: true,
+			},
+			{
+				Config: config("platform team"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_developer_metadata.test", "value", "platform team"),
+					func(s *terraform.State) error {
+						if metadata[0].MetadataValue != "platform team" {
+							return fmt.Errorf("Expected the value to be updated, got %s", metadata[0].MetadataValue)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:                         "gsheets_developer_metadata.test",
+				ImportState:                          true,
+				ImportStateId:                        "test-spreadsheet-id:1234",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "metadata_id",
+				// The metadata moved since it was created.
+				ImportStateVerifyIgnore: []string{"location"},
+			},
+		},
+	})
+}
@@ -0,0 +1,329 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/api/sheets/v4"
+)
+
+var _ resource.ResourceWithConfigure = &DimensionGroupResource{}
+
+func NewDimensionGroupResource() resource.Resource {
+	return &DimensionGroupResource{}
+}
+
+type DimensionGroupResource struct {
+	client *sheets.Service
+}
+
+type DimensionGroupResourceModel struct {
+	SpreadsheetID types.String `tfsdk:"spreadsheet_id"`
+	SheetID       types.Int64  `tfsdk:"sheet_id"`
+	Dimension     types.String `tfsdk:"dimension"`
+	StartIndex    types.Int64  `tfsdk:"start_index"`
+	EndIndex      types.Int64  `tfsdk:"end_index"`
+	Collapsed     types.Bool   `tfsdk:"collapsed"`
+	Depth         types.Int64  `tfsdk:"depth"`
+}
+
+func (m DimensionGroupResourceModel) ToDimensionRange() *sheets.DimensionRange {
+	return &sheets.DimensionRange{
+		SheetId:    m.SheetID.ValueInt64(),
+		Dimension:  m.Dimension.ValueString(),
+		StartIndex: m.StartIndex.ValueInt64(),
+		EndIndex:   m.EndIndex.ValueInt64(),
+		// Sheet 0 and index 0 are valid values that would be omitted otherwise.
+		ForceSendFields: []string{"SheetId", "StartIndex"},
+	}
+}
+
+// FindDimensionGroup returns the group of the sheet that spans exactly the given rows or columns. It returns nil if it doesn't exist.
+func FindDimensionGroup(groups []*sheets.DimensionGroup, dimensionRange *sheets.DimensionRange) *sheets.DimensionGroup {
+	for _, group := range groups {
+		if group.Range == nil {
+			continue
+		}
+		if group.Range.SheetId == dimensionRange.SheetId &&
+			group.Range.Dimension == dimensionRange.Dimension &&
+			group.Range.StartIndex == dimensionRange.StartIndex &&
+			group.Range.EndIndex == dimensionRange.EndIndex {
+			return group
+		}
+	}
+	return nil
+}
+
+// Groups returns the row or column groups of the sheet that match the dimension of the model.
+func (m DimensionGroupResourceModel) Groups(sheet *sheets.Sheet) []*sheets.DimensionGroup {
+	if m.Dimension.ValueString() == "ROWS" {
+		return sheet.RowGroups
+	}
+	return sheet.ColumnGroups
+}
+
+// BuildUpdateRequest returns the request that collapses or expands the group.
+func (m DimensionGroupResourceModel) BuildUpdateRequest() *sheets.Request {
+	return &sheets.Request{
+		UpdateDimensionGroup: &sheets.UpdateDimensionGroupRequest{
+			DimensionGroup: &sheets.DimensionGroup{
+				Range:     m.ToDimensionRange(),
+				Depth:     m.Depth.ValueInt64(),
+				Collapsed: m.Collapsed.ValueBool(),
+			},
+			Fields: "collapsed",
+		},
+	}
+}
+
+func (r *DimensionGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dimension_group"
+}
+
+func (r *DimensionGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Groups a span of rows or columns of a sheet so they can be collapsed under a control.
+
+Groups can be nested by creating a group inside another one.`,
+
+		Attributes: map[string]schema.Attribute{
+			"spreadsheet_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID for the spreadsheet. It can be obtained from the URL.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sheet_id": schema.Int64Attribute{
+				MarkdownDescription: "The sheet that contains the rows or columns. The first sheet of a spreadsheet usually has id `0`",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"dimension": schema.StringAttribute{
+				MarkdownDescription: "Either `ROWS` or `COLUMNS`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("ROWS", "COLUMNS"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"start_index": schema.Int64Attribute{
+				MarkdownDescription: "The first row or column, starting at 0",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"end_index": schema.Int64Attribute{
+				MarkdownDescription: "The row or column after the last one, so `start_index = 1` and `end_index = 5` are the rows 2 to 5",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"collapsed": schema.BoolAttribute{
+				MarkdownDescription: "True if the rows or columns of the group are hidden. Defaults to `false`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"depth": schema.Int64Attribute{
+				MarkdownDescription: "How many groups contain this one, starting at 1 for groups that are not nested",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure implements resource.ResourceWithConfigure.
+func (r *DimensionGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sheets.Service)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Type",
+			fmt.Sprintf("Expected *sheets.Service, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create is called when the provider must create a new resource. Config
+// and planned state values should be read from the
+// CreateRequest and new state values set on the CreateResponse.
+func (r *DimensionGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DimensionGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createRequest := r.client.Spreadsheets.BatchUpdate(data.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{AddDimensionGroup: &sheets.AddDimensionGroupRequest{
+				Range: data.ToDimensionRange(),
+			}},
+		},
+	})
+	createRequest.Context(ctx)
+	createResponse, err := createRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create dimension group", err.Error())
+		return
+	}
+
+	// The reply contains all the groups of the sheet, the depth depends on the groups around this one.
+	group := FindDimensionGroup(createResponse.Replies[0].AddDimensionGroup.DimensionGroups, data.ToDimensionRange())
+	if group == nil {
+		resp.Diagnostics.AddError("Unable to create dimension group", "The new group was not found in the sheet")
+		return
+	}
+	data.Depth = types.Int64Value(group.Depth)
+
+	if data.Collapsed.ValueBool() {
+		err = r.update(ctx, data)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to collapse dimension group", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read is called when the provider must read resource values in order
+// to update state. Planned state values should be read from the
+// ReadRequest and new state values set on the ReadResponse.
+func (r *DimensionGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DimensionGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getRequest := r.client.Spreadsheets.Get(data.SpreadsheetID.ValueString())
+	getRequest.Fields("spreadsheetId,sheets(properties,rowGroups,columnGroups)")
+	getRequest.Context(ctx)
+	spreadsheet, err := getRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read data,", err.Error())
+		return
+	}
+
+	sheet := FindSheetByID(spreadsheet, data.SheetID.ValueInt64())
+	if sheet == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	group := FindDimensionGroup(data.Groups(sheet), data.ToDimensionRange())
+	if group == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Collapsed = types.BoolValue(group.Collapsed)
+	data.Depth = types.Int64Value(group.Depth)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is called to update the state of the resource. Config, planned
+// state, and prior state values should be read from the
+// UpdateRequest and new state values set on the UpdateResponse.
+func (r *DimensionGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state DimensionGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The group is identified by its range and its depth.
+	data.Depth = state.Depth
+
+	err := r.update(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to perform update request", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete is called when the provider must delete the resource. Config
+// values may be read from the DeleteRequest.
+//
+// If execution completes without error, the framework will automatically
+// call DeleteResponse.State.RemoveResource(), so it can be omitted
+// from provider logic.
+func (r *DimensionGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DimensionGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteRequest := r.client.Spreadsheets.BatchUpdate(data.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{DeleteDimensionGroup: &sheets.DeleteDimensionGroupRequest{
+				Range: data.ToDimensionRange(),
+			}},
+		},
+	})
+	deleteRequest.Context(ctx)
+	_, err := deleteRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to delete dimension group", err.Error())
+		return
+	}
+}
+
+func (r *DimensionGroupResource) update(ctx context.Context, data DimensionGroupResourceModel) error {
+	updateRequest := r.client.Spreadsheets.BatchUpdate(data.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{data.BuildUpdateRequest()},
+	})
+	updateRequest.Context(ctx)
+	_, err := updateRequest.Do()
+	return err
+}
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestAccDimensionGroupResource(t *testing.T) {
+	var rowGroups []*sheets.DimensionGroup
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v4/spreadsheets/{spreadsheetId}", func(w http.ResponseWriter, r *http.Request) {
+		res := sheets.Spreadsheet{
+			SpreadsheetId: r.PathValue("spreadsheetId"),
+			Sheets: []*sheets.Sheet{
+				{
+					Properties: &sheets.SheetProperties{SheetId: 0, Title: "budgets"},
+					RowGroups:  rowGroups,
+				},
+			},
+		}
+		err := json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+	mux.HandleFunc("POST /v4/spreadsheets/{spreadsheetIdUpdate}", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		requestBody := &sheets.BatchUpdateSpreadsheetRequest{}
+		err := json.NewDecoder(r.Body).Decode(requestBody)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		res := sheets.BatchUpdateSpreadsheetResponse{
+			SpreadsheetId: strings.Split(r.PathValue("spreadsheetIdUpdate"), ":")[0],
+			Replies:       []*sheets.Response{{}},
+		}
+		request := requestBody.Requests[0]
+		switch {
+		case request.AddDimensionGroup != nil:
+			// Another group of the sheet, the new one is nested inside it.
+			rowGroups = []*sheets.DimensionGroup{
+				{Range: &sheets.DimensionRange{Dimension: "ROWS", StartIndex: 0, EndIndex: 20}, Depth: 1},
+				{Range: request.AddDimensionGroup.Range, Depth: 2},
+			}
+			res.Replies[0].AddDimensionGroup = &sheets.AddDimensionGroupResponse{DimensionGroups: rowGroups}
+		case request.UpdateDimensionGroup != nil:
+			group := request.UpdateDimensionGroup.DimensionGroup
+			if request.UpdateDimensionGroup.Fields != "collapsed" || group.Depth != 2 {
+				t.Errorf("Unexpected update %v", request.UpdateDimensionGroup)
+			}
+			rowGroups[1].Collapsed = group.Collapsed
+		case request.DeleteDimensionGroup != nil:
+			rowGroups = rowGroups[:1]
+		default:
+			t.Errorf("Unexpected request %v", request)
+		}
+
+		err = json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	config := func(collapsed bool) string {
+		return fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_dimension_group" "test" {
+	spreadsheet_id = "test-spreadsheet-id"
+	sheet_id = 0
+	dimension = "ROWS"
+	start_index = 2
+	end_index = 8
+	collapsed = %t
+}
+`, server.URL, collapsed)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		CheckDestroy: func(s *terraform.State) error {
+			if len(rowGroups) != 1 {
+				return fmt.Errorf("Expected the group to be deleted, got %v", rowGroups)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_dimension_group.test", "depth", "2"),
+					resource.TestCheckResourceAttr("gsheets_dimension_group.test", "collapsed", "true"),
+					func(s *terraform.State) error {
+						if !rowGroups[1].Collapsed {
+							return fmt.Errorf("Expected the group to be collapsed")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// Someone expanded the group from the UI, it must be collapsed again.
+				PreConfig: func() {
+					rowGroups[1].Collapsed = false
+				},
+				Config: config(true),
+				Check: func(s *terraform.State) error {
+					if !rowGroups[1].Collapsed {
+						return fmt.Errorf("Expected the group to be collapsed again")
+					}
+					return nil
+				},
+			},
+			{
+				Config: config(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_dimension_group.test", "collapsed", "false"),
+					func(s *terraform.State) error {
+						if rowGroups[1].Collapsed {
+							return fmt.Errorf("Expected the group to be expanded")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// The group was removed from the UI, it must be created again.
+				PreConfig: func() {
+					rowGroups = rowGroups[:1]
+				},
+				Config: config(false),
+				Check:  resource.TestCheckResourceAttr("gsheets_dimension_group.test", "depth", "2"),
+			},
+		},
+	})
+}
@@ -0,0 +1,387 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/sheets/v4"
+)
+
+var _ resource.ResourceWithConfigure = &DimensionPropertiesResource{}
+
+// Default sizes of new rows and columns in google sheets. They are restored when the resource is deleted.
+const (
+	DefaultRowPixelSize    = 21
+	DefaultColumnPixelSize = 100
+)
+
+func NewDimensionPropertiesResource() resource.Resource {
+	return &DimensionPropertiesResource{}
+}
+
+type DimensionPropertiesResource struct {
+	client *sheets.Service
+}
+
+type DimensionPropertiesResourceModel struct {
+	SpreadsheetID types.String `tfsdk:"spreadsheet_id"`
+	SheetID       types.Int64  `tfsdk:"sheet_id"`
+	Dimension     types.String `tfsdk:"dimension"`
+	StartIndex    types.Int64  `tfsdk:"start_index"`
+	EndIndex      types.Int64  `tfsdk:"end_index"`
+	PixelSize     types.Int64  `tfsdk:"pixel_size"`
+	HiddenByUser  types.Bool   `tfsdk:"hidden_by_user"`
+	AutoResize    types.Bool   `tfsdk:"auto_resize"`
+}
+
+func (m DimensionPropertiesResourceModel) ToDimensionRange() *sheets.DimensionRange {
+	return &sheets.DimensionRange{
+		SheetId:    m.SheetID.ValueInt64(),
+		Dimension:  m.Dimension.ValueString(),
+		StartIndex: m.StartIndex.ValueInt64(),
+		EndIndex:   m.EndIndex.ValueInt64(),
+		// Sheet 0 and index 0 are valid values that would be omitted otherwise.
+		ForceSendFields: []string{"SheetId", "StartIndex"},
+	}
+}
+
+// ToGridRange returns the whole rows or columns covered by the resource.
+func (m DimensionPropertiesResourceModel) ToGridRange() *sheets.GridRange {
+	gridRange := &sheets.GridRange{SheetId: m.SheetID.ValueInt64()}
+	if m.Dimension.ValueString() == "ROWS" {
+		gridRange.StartRowIndex = m.StartIndex.ValueInt64()
+		gridRange.EndRowIndex = m.EndIndex.ValueInt64()
+	} else {
+		gridRange.StartColumnIndex = m.StartIndex.ValueInt64()
+		gridRange.EndColumnIndex = m.EndIndex.ValueInt64()
+	}
+	return gridRange
+}
+
+// DefaultPixelSize returns the size of a new row or column.
+func (m DimensionPropertiesResourceModel) DefaultPixelSize() int64 {
+	if m.Dimension.ValueString() == "ROWS" {
+		return DefaultRowPixelSize
+	}
+	return DefaultColumnPixelSize
+}
+
+// BuildRequests returns the requests that move the properties from the previous model to this one.
+// Properties that are no longer managed are restored to the defaults of google sheets.
+func (m DimensionPropertiesResourceModel) BuildRequests(previous *DimensionPropertiesResourceModel) []*sheets.Request {
+	properties := &sheets.DimensionProperties{
+		PixelSize:    m.PixelSize.ValueInt64(),
+		HiddenByUser: m.HiddenByUser.ValueBool(),
+	}
+	var fields []string
+	if !m.PixelSize.IsNull() {
+		fields = append(fields, "pixelSize")
+	} else if previous != nil && !previous.PixelSize.IsNull() && !m.AutoResize.ValueBool() {
+		properties.PixelSize = m.DefaultPixelSize()
+		fields = append(fields, "pixelSize")
+	}
+	if !m.HiddenByUser.IsNull() || (previous != nil && !previous.HiddenByUser.IsNull()) {
+		fields = append(fields, "hiddenByUser")
+	}
+
+	var requests []*sheets.Request
+	if len(fields) > 0 {
+		requests = append(requests, &sheets.Request{
+			UpdateDimensionProperties: &sheets.UpdateDimensionPropertiesRequest{
+				Range:      m.ToDimensionRange(),
+				Properties: properties,
+				Fields:     strings.Join(fields, ","),
+			},
+		})
+	}
+	if m.AutoResize.ValueBool() {
+		requests = append(requests, &sheets.Request{
+			AutoResizeDimensions: &sheets.AutoResizeDimensionsRequest{
+				Dimensions: m.ToDimensionRange(),
+			},
+		})
+	}
+	return requests
+}
+
+// Refresh updates the managed properties with the metadata of the sheet.
+// When the rows or columns don't share the same properties, the first value that differs is kept so the drift shows up in the plan.
+func (m *DimensionPropertiesResourceModel) Refresh(sheet *sheets.Sheet) {
+	var metadata []*sheets.DimensionProperties
+	for _, data := range sheet.Data {
+		if m.Dimension.ValueString() == "ROWS" {
+			metadata = append(metadata, data.RowMetadata...)
+		} else {
+			metadata = append(metadata, data.ColumnMetadata...)
+		}
+	}
+
+	for _, properties := range metadata {
+		if !m.PixelSize.IsNull() && properties.PixelSize != m.PixelSize.ValueInt64() {
+			m.PixelSize = types.Int64Value(properties.PixelSize)
+			break
+		}
+	}
+	for _, properties := range metadata {
+		if !m.HiddenByUser.IsNull() && properties.HiddenByUser != m.HiddenByUser.ValueBool() {
+			m.HiddenByUser = types.BoolValue(properties.HiddenByUser)
+			break
+		}
+	}
+}
+
+func (r *DimensionPropertiesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dimension_properties"
+}
+
+func (r *DimensionPropertiesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Sets the size and visibility of a span of rows or columns of a sheet.
+
+Only the attributes that are set are managed. When the resource is deleted, the rows or columns are shown again
+and their size is restored to the default of google sheets.`,
+
+		Attributes: map[string]schema.Attribute{
+			"spreadsheet_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID for the spreadsheet. It can be obtained from the URL.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sheet_id": schema.Int64Attribute{
+				MarkdownDescription: "The sheet that contains the rows or columns. The first sheet of a spreadsheet usually has id `0`",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"dimension": schema.StringAttribute{
+				MarkdownDescription: "Either `ROWS` or `COLUMNS`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("ROWS", "COLUMNS"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"start_index": schema.Int64Attribute{
+				MarkdownDescription: "The first row or column, starting at 0",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"end_index": schema.Int64Attribute{
+				MarkdownDescription: "The row or column after the last one, so `start_index = 0` and `end_index = 3` are the columns A to C",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"pixel_size": schema.Int64Attribute{
+				MarkdownDescription: "The height of the rows or the width of the columns in pixels",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"hidden_by_user": schema.BoolAttribute{
+				MarkdownDescription: "True if the rows or columns are hidden",
+				Optional:            true,
+			},
+			"auto_resize": schema.BoolAttribute{
+				MarkdownDescription: "Resizes the rows or columns to fit their contents when the resource is created or updated. The resulting size is not tracked, so later changes to the contents don't cause a new resize: change another attribute or replace the resource to run it again.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("pixel_size")),
+				},
+			},
+		},
+	}
+}
+
+// Configure implements resource.ResourceWithConfigure.
+func (r *DimensionPropertiesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sheets.Service)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Type",
+			fmt.Sprintf("Expected *sheets.Service, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create is called when the provider must create a new resource. Config
+// and planned state values should be read from the
+// CreateRequest and new state values set on the CreateResponse.
+func (r *DimensionPropertiesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DimensionPropertiesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.update(ctx, data.SpreadsheetID.ValueString(), data.BuildRequests(nil))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to update dimension properties", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read is called when the provider must read resource values in order
+// to update state. Planned state values should be read from the
+// ReadRequest and new state values set on the ReadResponse.
+func (r *DimensionPropertiesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DimensionPropertiesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getRequest := r.client.Spreadsheets.Get(data.SpreadsheetID.ValueString())
+	getRequest.Fields("spreadsheetId,sheets.properties")
+	getRequest.Context(ctx)
+	spreadsheet, err := getRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read data,", err.Error())
+		return
+	}
+
+	sheet := FindSheetByID(spreadsheet, data.SheetID.ValueInt64())
+	if sheet == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	metadata := "columnMetadata"
+	if data.Dimension.ValueString() == "ROWS" {
+		metadata = "rowMetadata"
+	}
+	getRequest = r.client.Spreadsheets.Get(data.SpreadsheetID.ValueString())
+	getRequest.Ranges(FormatA1(sheet.Properties.Title, data.ToGridRange()))
+	getRequest.IncludeGridData(true)
+	getRequest.Fields(googleapi.Field("spreadsheetId,sheets(properties,data(startRow,startColumn," + metadata + "(pixelSize,hiddenByUser)))"))
+	getRequest.Context(ctx)
+	spreadsheet, err = getRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read data,", err.Error())
+		return
+	}
+
+	data.Refresh(FindSheetByID(spreadsheet, data.SheetID.ValueInt64()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is called to update the state of the resource. Config, planned
+// state, and prior state values should be read from the
+// UpdateRequest and new state values set on the UpdateResponse.
+func (r *DimensionPropertiesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state DimensionPropertiesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.update(ctx, data.SpreadsheetID.ValueString(), data.BuildRequests(&state))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to perform update request", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete is called when the provider must delete the resource. Config
+// values may be read from the DeleteRequest.
+//
+// If execution completes without error, the framework will automatically
+// call DeleteResponse.State.RemoveResource(), so it can be omitted
+// from provider logic.
+func (r *DimensionPropertiesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DimensionPropertiesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Rows or columns that were resized automatically are restored as well,
+	// marking their size as managed is enough for BuildRequests to reset it.
+	if data.AutoResize.ValueBool() {
+		data.PixelSize = types.Int64Value(0)
+	}
+	defaults := DimensionPropertiesResourceModel{
+		SpreadsheetID: data.SpreadsheetID,
+		SheetID:       data.SheetID,
+		Dimension:     data.Dimension,
+		StartIndex:    data.StartIndex,
+		EndIndex:      data.EndIndex,
+		PixelSize:     types.Int64Null(),
+		HiddenByUser:  types.BoolNull(),
+		AutoResize:    types.BoolValue(false),
+	}
+	err := r.update(ctx, data.SpreadsheetID.ValueString(), defaults.BuildRequests(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to restore dimension properties", err.Error())
+		return
+	}
+}
+
+func (r *DimensionPropertiesResource) update(ctx context.Context, spreadsheetID string, requests []*sheets.Request) error {
+	if len(requests) == 0 {
+		return nil
+	}
+
+	updateRequest := r.client.Spreadsheets.BatchUpdate(spreadsheetID, &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: requests,
+	})
+	updateRequest.Context(ctx)
+	_, err := updateRequest.Do()
+	return err
+}
@@ -0,0 +1,218 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestAccDimensionPropertiesResource(t *testing.T) {
+	columns := make([]*sheets.DimensionProperties, 5)
+	for i := range columns {
+		columns[i] = &sheets.DimensionProperties{PixelSize: DefaultColumnPixelSize}
+	}
+	autoResized := 0
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v4/spreadsheets/{spreadsheetId}", func(w http.ResponseWriter, r *http.Request) {
+		sheet := &sheets.Sheet{
+			Properties: &sheets.SheetProperties{
+				SheetId:        2,
+				Title:          "test title",
+				GridProperties: &sheets.GridProperties{RowCount: 100, ColumnCount: int64(len(columns))},
+			},
+		}
+		if r.URL.Query().Get("includeGridData") == "true" {
+			_, cells, err := SplitA1(r.URL.Query().Get("ranges"))
+			if err != nil {
+				t.Error(err)
+			}
+			gridRange, err := ParseCells(cells)
+			if err != nil {
+				t.Error(err)
+			}
+			sheet.Data = []*sheets.GridData{{
+				StartColumn:    gridRange.StartColumnIndex,
+				ColumnMetadata: columns[gridRange.StartColumnIndex:gridRange.EndColumnIndex],
+			}}
+		}
+
+		res := sheets.Spreadsheet{
+			SpreadsheetId: r.PathValue("spreadsheetId"),
+			Sheets:        []*sheets.Sheet{sheet},
+		}
+		err := json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+	mux.HandleFunc("POST /v4/spreadsheets/{spreadsheetIdUpdate}", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		requestBody := &sheets.BatchUpdateSpreadsheetRequest{}
+		err := json.NewDecoder(r.Body).Decode(requestBody)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		for _, request := range requestBody.Requests {
+			switch {
+			case request.UpdateDimensionProperties != nil:
+				update := request.UpdateDimensionProperties
+				if update.Range.Dimension != "COLUMNS" || update.Range.SheetId != 2 {
+					t.Errorf("Unexpected range %v", update.Range)
+				}
+				for i := update.Range.StartIndex; i < update.Range.EndIndex; i++ {
+					column := *columns[i]
+					for _, field := range strings.Split(update.Fields, ",") {
+						switch field {
+						case "pixelSize":
+							column.PixelSize = update.Properties.PixelSize
+						case "hiddenByUser":
+							column.HiddenByUser = update.Properties.HiddenByUser
+						default:
+							t.Errorf("Unexpected field %s", field)
+						}
+					}
+					columns[i] = &column
+				}
+			case request.AutoResizeDimensions != nil:
+				autoResized++
+				for i := request.AutoResizeDimensions.Dimensions.StartIndex; i < request.AutoResizeDimensions.Dimensions.EndIndex; i++ {
+					columns[i] = &sheets.DimensionProperties{PixelSize: 42, HiddenByUser: columns[i].HiddenByUser}
+				}
+			default:
+				t.Errorf("Unexpected request %v", request)
+			}
+		}
+
+		res := sheets.BatchUpdateSpreadsheetResponse{
+			SpreadsheetId: strings.Split(r.PathValue("spreadsheetIdUpdate"), ":")[0],
+			Replies:       make([]*sheets.Response, len(requestBody.Requests)),
+		}
+		err = json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_dimension_properties" "columns" {
+	spreadsheet_id = "test-spreadsheet-id"
+	sheet_id = 2
+	dimension = "COLUMNS"
+	start_index = 1
+	end_index = 3
+	pixel_size = 150
+	hidden_by_user = false
+}
+	`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_dimension_properties.columns", "pixel_size", "150"),
+					resource.TestCheckResourceAttr("gsheets_dimension_properties.columns", "auto_resize", "false"),
+					func(s *terraform.State) error {
+						if columns[0].PixelSize != DefaultColumnPixelSize || columns[1].PixelSize != 150 || columns[2].PixelSize != 150 || columns[3].PixelSize != DefaultColumnPixelSize {
+							return fmt.Errorf("Unexpected columns %v %v %v %v", columns[0], columns[1], columns[2], columns[3])
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// Someone resized and hid a column by hand, the properties must be applied again.
+				PreConfig: func() {
+					columns[2] = &sheets.DimensionProperties{PixelSize: 80, HiddenByUser: true}
+				},
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_dimension_properties" "columns" {
+	spreadsheet_id = "test-spreadsheet-id"
+	sheet_id = 2
+	dimension = "COLUMNS"
+	start_index = 1
+	end_index = 3
+	pixel_size = 150
+	hidden_by_user = false
+}
+	`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_dimension_properties.columns", "pixel_size", "150"),
+					func(s *terraform.State) error {
+						if columns[2].PixelSize != 150 || columns[2].HiddenByUser {
+							return fmt.Errorf("Expected the properties to be applied again, got %v", columns[2])
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_dimension_properties" "columns" {
+	spreadsheet_id = "test-spreadsheet-id"
+	sheet_id = 2
+	dimension = "COLUMNS"
+	start_index = 1
+	end_index = 3
+	auto_resize = true
+}
+	`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("gsheets_dimension_properties.columns", "pixel_size"),
+					resource.TestCheckResourceAttr("gsheets_dimension_properties.columns", "auto_resize", "true"),
+					func(s *terraform.State) error {
+						if autoResized != 1 {
+							return fmt.Errorf("Expected the columns to be resized once, got %d", autoResized)
+						}
+						if columns[1].PixelSize != 42 {
+							return fmt.Errorf("Expected the auto resized width, got %v", columns[1])
+						}
+						return nil
+					},
+				),
+			},
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			for i, column := range columns {
+				if column.PixelSize != DefaultColumnPixelSize || column.HiddenByUser {
+					return fmt.Errorf("Expected column %d to be restored, got %v", i, column)
+				}
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/api/sheets/v4"
+)
+
+type SortSpecModel struct {
+	ColumnIndex types.Int64  `tfsdk:"column_index"`
+	SortOrder   types.String `tfsdk:"sort_order"`
+}
+
+type FilterSpecModel struct {
+	ColumnIndex  types.Int64            `tfsdk:"column_index"`
+	Condition    *BooleanConditionModel `tfsdk:"condition"`
+	HiddenValues []types.String         `tfsdk:"hidden_values"`
+}
+
+func ToSortSpecs(models []SortSpecModel) []*sheets.SortSpec {
+	var specs []*sheets.SortSpec
+	for _, m := range models {
+		specs = append(specs, &sheets.SortSpec{
+			DimensionIndex: m.ColumnIndex.ValueInt64(),
+			SortOrder:      m.SortOrder.ValueString(),
+			// The first column has index 0, which would be omitted otherwise.
+			ForceSendFields: []string{"DimensionIndex"},
+		})
+	}
+	return specs
+}
+
+func ToFilterSpecs(models []FilterSpecModel) []*sheets.FilterSpec {
+	var specs []*sheets.FilterSpec
+	for _, m := range models {
+		specs = append(specs, &sheets.FilterSpec{
+			ColumnIndex: m.ColumnIndex.ValueInt64(),
+			FilterCriteria: &sheets.FilterCriteria{
+				Condition:    m.Condition.ToBooleanCondition(),
+				HiddenValues: StringsFromValues(m.HiddenValues),
+			},
+			ForceSendFields: []string{"ColumnIndex"},
+		})
+	}
+	return specs
+}
+
+// NewSortSpecModels is the inverse of ToSortSpecs.
+func NewSortSpecModels(specs []*sheets.SortSpec) []SortSpecModel {
+	var models []SortSpecModel
+	for _, spec := range specs {
+		models = append(models, SortSpecModel{
+			ColumnIndex: types.Int64Value(spec.DimensionIndex),
+			SortOrder:   types.StringValue(spec.SortOrder),
+		})
+	}
+	return models
+}
+
+// NewFilterSpecModels is the inverse of ToFilterSpecs.
+func NewFilterSpecModels(specs []*sheets.FilterSpec) []FilterSpecModel {
+	var models []FilterSpecModel
+	for _, spec := range specs {
+		m := FilterSpecModel{
+			ColumnIndex: types.Int64Value(spec.ColumnIndex),
+		}
+		if spec.FilterCriteria != nil {
+			m.Condition = NewBooleanConditionModel(spec.FilterCriteria.Condition)
+			for _, value := range spec.FilterCriteria.HiddenValues {
+				m.HiddenValues = append(m.HiddenValues, types.StringValue(value))
+			}
+		}
+		models = append(models, m)
+	}
+	return models
+}
+
+// RefreshSortSpecs returns the specs returned by the API, keeping the current ones if they are equivalent.
+func RefreshSortSpecs(current []SortSpecModel, specs []*sheets.SortSpec) []SortSpecModel {
+	refreshed := NewSortSpecModels(specs)
+	if jsonEqual(ToSortSpecs(current), ToSortSpecs(refreshed)) {
+		return current
+	}
+	return refreshed
+}
+
+// RefreshFilterSpecs returns the specs returned by the API, keeping the current ones if they are equivalent.
+func RefreshFilterSpecs(current []FilterSpecModel, specs []*sheets.FilterSpec) []FilterSpecModel {
+	refreshed := NewFilterSpecModels(specs)
+	if jsonEqual(ToFilterSpecs(current), ToFilterSpecs(refreshed)) {
+		return current
+	}
+	return refreshed
+}
+
+func jsonEqual(a, b any) bool {
+	aJSON, _ := json.Marshal(a)
+	bJSON, _ := json.Marshal(b)
+	return string(aJSON) == string(bJSON)
+}
+
+// sortSpecsAttribute is shared by all the resources that sort ranges.
+func sortSpecsAttribute(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"column_index": schema.Int64Attribute{
+					MarkdownDescription: "The column to sort by. Column A of the sheet has index 0",
+					Required:            true,
+					Validators: []validator.Int64{
+						int64validator.AtLeast(0),
+					},
+				},
+				"sort_order": schema.StringAttribute{
+					MarkdownDescription: "Either `ASCENDING` or `DESCENDING`",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("ASCENDING", "DESCENDING"),
+					},
+				},
+			},
+		},
+	}
+}
+
+// filterSpecsAttribute is shared by all the resources that filter ranges.
+func filterSpecsAttribute(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"column_index": schema.Int64Attribute{
+					MarkdownDescription: "The column to filter. Column A of the sheet has index 0",
+					Required:            true,
+					Validators: []validator.Int64{
+						int64validator.AtLeast(0),
+					},
+				},
+				"condition": booleanConditionAttribute("Only the rows that match the condition are shown", false),
+				"hidden_values": schema.ListAttribute{
+					MarkdownDescription: "Rows with any of these values in the column are hidden",
+					ElementType:         types.StringType,
+					Optional:            true,
+				},
+			},
+		},
+	}
+}
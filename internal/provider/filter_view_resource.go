@@ -0,0 +1,319 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"google.golang.org/api/sheets/v4"
+)
+
+var _ resource.ResourceWithConfigure = &FilterViewResource{}
+var _ resource.ResourceWithImportState = &FilterViewResource{}
+
+func NewFilterViewResource() resource.Resource {
+	return &FilterViewResource{}
+}
+
+type FilterViewResource struct {
+	client *sheets.Service
+}
+
+type FilterViewResourceModel struct {
+	SpreadsheetID types.String      `tfsdk:"spreadsheet_id"`
+	FilterViewID  types.Int64       `tfsdk:"filter_view_id"`
+	Title         types.String      `tfsdk:"title"`
+	Range         types.String      `tfsdk:"range"`
+	SortSpecs     []SortSpecModel   `tfsdk:"sort_specs"`
+	FilterSpecs   []FilterSpecModel `tfsdk:"filter_specs"`
+}
+
+func (m FilterViewResourceModel) ToFilterView(spreadsheet *sheets.Spreadsheet) (*sheets.FilterView, error) {
+	gridRange, err := GridRangeFromA1(spreadsheet, m.Range.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	return &sheets.FilterView{
+		FilterViewId: m.FilterViewID.ValueInt64(),
+		Title:        m.Title.ValueString(),
+		Range:        gridRange,
+		SortSpecs:    ToSortSpecs(m.SortSpecs),
+		FilterSpecs:  ToFilterSpecs(m.FilterSpecs),
+	}, nil
+}
+
+// Refresh updates the model with the filter view returned by the API.
+func (m *FilterViewResourceModel) Refresh(spreadsheet *sheets.Spreadsheet, view *sheets.FilterView) error {
+	var err error
+	m.FilterViewID = types.Int64Value(view.FilterViewId)
+	m.Title = types.StringValue(view.Title)
+	m.Range, err = RefreshA1(spreadsheet, m.Range, view.Range)
+	if err != nil {
+		return err
+	}
+	m.SortSpecs = RefreshSortSpecs(m.SortSpecs, view.SortSpecs)
+	m.FilterSpecs = RefreshFilterSpecs(m.FilterSpecs, view.FilterSpecs)
+	return nil
+}
+
+// FindFilterView returns the filter view with the given id. It returns nil if it doesn't exist.
+func FindFilterView(spreadsheet *sheets.Spreadsheet, id int64) *sheets.FilterView {
+	for _, sheet := range spreadsheet.Sheets {
+		for _, view := range sheet.FilterViews {
+			if view.FilterViewId == id {
+				return view
+			}
+		}
+	}
+	return nil
+}
+
+func (r *FilterViewResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_filter_view"
+}
+
+func (r *FilterViewResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages a filter view of a sheet.
+
+Filter views are saved filters that anyone can apply without changing what other people see.`,
+
+		Attributes: map[string]schema.Attribute{
+			"spreadsheet_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID for the spreadsheet. It can be obtained from the URL.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"filter_view_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the filter view",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "The name of the filter view",
+				Required:            true,
+			},
+			"range": schema.StringAttribute{
+				MarkdownDescription: "The range the filter view covers in A1 notation. Use the sheet title to point to a specific sheet.",
+				Required:            true,
+			},
+			"sort_specs":   sortSpecsAttribute("The sort order of the rows. Later specifications are used when the values of the previous columns are equal."),
+			"filter_specs": filterSpecsAttribute("The criteria used to show or hide rows"),
+		},
+	}
+}
+
+// Configure implements resource.ResourceWithConfigure.
+func (r *FilterViewResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sheets.Service)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Type",
+			fmt.Sprintf("Expected *sheets.Service, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create is called when the provider must create a new resource. Config
+// and planned state values should be read from the
+// CreateRequest and new state values set on the CreateResponse.
+func (r *FilterViewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FilterViewResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spreadsheet, err := r.getSpreadsheet(ctx, data.SpreadsheetID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read data,", err.Error())
+		return
+	}
+
+	view, err := data.ToFilterView(spreadsheet)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid range", err.Error())
+		return
+	}
+	view.FilterViewId = 0
+
+	createRequest := r.client.Spreadsheets.BatchUpdate(data.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{AddFilterView: &sheets.AddFilterViewRequest{
+				Filter: view,
+			}},
+		},
+	})
+	createRequest.Context(ctx)
+	createResponse, err := createRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create filter view", err.Error())
+		return
+	}
+
+	data.FilterViewID = types.Int64Value(createResponse.Replies[0].AddFilterView.Filter.FilterViewId)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (r *FilterViewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ":", 2)
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError("ID is not correct", "The ID must be a <spreadsheet_id>:<filter_view_id>, but it was "+req.ID)
+		return
+	}
+
+	id, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("ID is not correct", "The filter view id must be a number, but it was "+parts[1])
+		return
+	}
+
+	data := FilterViewResourceModel{
+		SpreadsheetID: basetypes.NewStringValue(parts[0]),
+		FilterViewID:  basetypes.NewInt64Value(id),
+		Title:         basetypes.NewStringNull(),
+		Range:         basetypes.NewStringNull(),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read is called when the provider must read resource values in order
+// to update state. Planned state values should be read from the
+// ReadRequest and new state values set on the ReadResponse.
+func (r *FilterViewResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FilterViewResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spreadsheet, err := r.getSpreadsheet(ctx, data.SpreadsheetID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read data,", err.Error())
+		return
+	}
+
+	view := FindFilterView(spreadsheet, data.FilterViewID.ValueInt64())
+	if view == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	err = data.Refresh(spreadsheet, view)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read filter view", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is called to update the state of the resource. Config, planned
+// state, and prior state values should be read from the
+// UpdateRequest and new state values set on the UpdateResponse.
+func (r *FilterViewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data FilterViewResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spreadsheet, err := r.getSpreadsheet(ctx, data.SpreadsheetID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read data,", err.Error())
+		return
+	}
+
+	view, err := data.ToFilterView(spreadsheet)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid range", err.Error())
+		return
+	}
+
+	updateRequest := r.client.Spreadsheets.BatchUpdate(data.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{UpdateFilterView: &sheets.UpdateFilterViewRequest{
+				Filter: view,
+				Fields: "title,range,sortSpecs,filterSpecs",
+			}},
+		},
+	})
+	updateRequest.Context(ctx)
+	_, err = updateRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to perform update request", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete is called when the provider must delete the resource. Config
+// values may be read from the DeleteRequest.
+//
+// If execution completes without error, the framework will automatically
+// call DeleteResponse.State.RemoveResource(), so it can be omitted
+// from provider logic.
+func (r *FilterViewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FilterViewResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteRequest := r.client.Spreadsheets.BatchUpdate(data.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{DeleteFilterView: &sheets.DeleteFilterViewRequest{
+				FilterId: data.FilterViewID.ValueInt64(),
+			}},
+		},
+	})
+	deleteRequest.Context(ctx)
+	_, err := deleteRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to delete filter view", err.Error())
+		return
+	}
+}
+
+func (r *FilterViewResource) getSpreadsheet(ctx context.Context, spreadsheetID string) (*sheets.Spreadsheet, error) {
+	getRequest := r.client.Spreadsheets.Get(spreadsheetID)
+	getRequest.Fields("spreadsheetId,sheets(properties,filterViews)")
+	getRequest.Context(ctx)
+	return getRequest.Do()
+}
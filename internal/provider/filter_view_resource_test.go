@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestAccFilterViewResource(t *testing.T) {
+	var filterViews []*sheets.FilterView
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v4/spreadsheets/{spreadsheetId}", func(w http.ResponseWriter, r *http.Request) {
+		res := sheets.Spreadsheet{
+			SpreadsheetId: r.PathValue("spreadsheetId"),
+			Sheets: []*sheets.Sheet{
+				{
+					Properties:  &sheets.SheetProperties{SheetId: 2, Title: "roster"},
+					FilterViews: filterViews,
+				},
+			},
+		}
+		err := json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+	mux.HandleFunc("POST /v4/spreadsheets/{spreadsheetIdUpdate}", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		requestBody := &sheets.BatchUpdateSpreadsheetRequest{}
+		err := json.NewDecoder(r.Body).Decode(requestBody)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		res := sheets.BatchUpdateSpreadsheetResponse{
+			SpreadsheetId: strings.Split(r.PathValue("spreadsheetIdUpdate"), ":")[0],
+			Replies:       []*sheets.Response{{}},
+		}
+		request := requestBody.Requests[0]
+		switch {
+		case request.AddFilterView != nil:
+			view := request.AddFilterView.Filter
+			view.FilterViewId = 7
+			filterViews = []*sheets.FilterView{view}
+			res.Replies[0].AddFilterView = &sheets.AddFilterViewResponse{Filter: view}
+		case request.UpdateFilterView != nil:
+			if request.UpdateFilterView.Fields != "title,range,sortSpecs,filterSpecs" {
+				t.Errorf("Unexpected fields %s", request.UpdateFilterView.Fields)
+			}
+			filterViews = []*sheets.FilterView{request.UpdateFilterView.Filter}
+		case request.DeleteFilterView != nil:
+			filterViews = nil
+		default:
+			t.Errorf("Unexpected request %v", request)
+		}
+
+		err = json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_filter_view" "team" {
+	spreadsheet_id = "test-spreadsheet-id"
+	title = "Team A"
+	range = "roster!A1:D"
+	sort_specs = [{
+		column_index = 0
+		sort_order = "ASCENDING"
+	}]
+	filter_specs = [{
+		column_index = 2
+		condition = {
+			type = "TEXT_EQ"
+			values = ["A"]
+		}
+	}]
+}
+	`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_filter_view.team", "filter_view_id", "7"),
+					resource.TestCheckResourceAttr("gsheets_filter_view.team", "range", "roster!A1:D"),
+					resource.TestCheckResourceAttr("gsheets_filter_view.team", "sort_specs.0.column_index", "0"),
+					resource.TestCheckResourceAttr("gsheets_filter_view.team", "filter_specs.0.condition.type", "TEXT_EQ"),
+					resource.TestCheckNoResourceAttr("gsheets_filter_view.team", "filter_specs.0.hidden_values"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_filter_view" "team" {
+	spreadsheet_id = "test-spreadsheet-id"
+	title = "Team B"
+	range = "roster!A1:D"
+	filter_specs = [{
+		column_index = 2
+		hidden_values = ["A", "C"]
+	}]
+}
+	`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_filter_view.team", "filter_view_id", "7"),
+					resource.TestCheckResourceAttr("gsheets_filter_view.team", "title", "Team B"),
+					resource.TestCheckNoResourceAttr("gsheets_filter_view.team", "sort_specs"),
+					resource.TestCheckResourceAttr("gsheets_filter_view.team", "filter_specs.0.hidden_values.#", "2"),
+				),
+			},
+			{
+				ResourceName:                         "gsheets_filter_view.team",
+				ImportState:                          true,
+				ImportStateId:                        "test-spreadsheet-id:7",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "filter_view_id",
+				// The imported range uses the notation returned by the API.
+				ImportStateVerifyIgnore: []string{"range"},
+			},
+		},
+	})
+}
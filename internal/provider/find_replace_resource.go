@@ -0,0 +1,284 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/api/sheets/v4"
+)
+
+var _ resource.ResourceWithConfigure = &FindReplaceResource{}
+
+func NewFindReplaceResource() resource.Resource {
+	return &FindReplaceResource{}
+}
+
+type FindReplaceResource struct {
+	client *sheets.Service
+}
+
+type FindReplaceResourceModel struct {
+	SpreadsheetID      types.String `tfsdk:"spreadsheet_id"`
+	Find               types.String `tfsdk:"find"`
+	Replacement        types.String `tfsdk:"replacement"`
+	Range              types.String `tfsdk:"range"`
+	SheetID            types.Int64  `tfsdk:"sheet_id"`
+	MatchCase          types.Bool   `tfsdk:"match_case"`
+	MatchEntireCell    types.Bool   `tfsdk:"match_entire_cell"`
+	SearchByRegex      types.Bool   `tfsdk:"search_by_regex"`
+	IncludeFormulas    types.Bool   `tfsdk:"include_formulas"`
+	Triggers           types.Map    `tfsdk:"triggers"`
+	OccurrencesChanged types.Int64  `tfsdk:"occurrences_changed"`
+	RowsChanged        types.Int64  `tfsdk:"rows_changed"`
+	SheetsChanged      types.Int64  `tfsdk:"sheets_changed"`
+}
+
+// ToFindReplaceRequest builds the request. Without a range or a sheet, every sheet is searched.
+func (m FindReplaceResourceModel) ToFindReplaceRequest(spreadsheet *sheets.Spreadsheet) (*sheets.FindReplaceRequest, error) {
+	request := &sheets.FindReplaceRequest{
+		Find:            m.Find.ValueString(),
+		Replacement:     m.Replacement.ValueString(),
+		MatchCase:       m.MatchCase.ValueBool(),
+		MatchEntireCell: m.MatchEntireCell.ValueBool(),
+		SearchByRegex:   m.SearchByRegex.ValueBool(),
+		IncludeFormulas: m.IncludeFormulas.ValueBool(),
+		// An empty replacement removes the matches.
+		ForceSendFields: []string{"Replacement"},
+	}
+
+	switch {
+	case !m.Range.IsNull():
+		gridRange, err := GridRangeFromA1(spreadsheet, m.Range.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		request.Range = gridRange
+	case !m.SheetID.IsNull():
+		if FindSheetByID(spreadsheet, m.SheetID.ValueInt64()) == nil {
+			return nil, fmt.Errorf("sheet %d not found in spreadsheet %s", m.SheetID.ValueInt64(), spreadsheet.SpreadsheetId)
+		}
+		request.SheetId = m.SheetID.ValueInt64()
+		// The first sheet usually has id 0, which would be omitted otherwise.
+		request.ForceSendFields = append(request.ForceSendFields, "SheetId")
+	default:
+		request.AllSheets = true
+	}
+	return request, nil
+}
+
+func (r *FindReplaceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_find_replace"
+}
+
+func (r *FindReplaceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	option := func(description string) schema.BoolAttribute {
+		return schema.BoolAttribute{
+			MarkdownDescription: description,
+			Optional:            true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplace(),
+			},
+		}
+	}
+	count := func(description string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			MarkdownDescription: description,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Finds and replaces text in a range, a sheet or the whole spreadsheet.
+
+The replacement runs once when the resource is created. Changing any attribute, or any value of ` + "`triggers`" + `, runs it again. Destroying the resource doesn't undo the replacement.`,
+
+		Attributes: map[string]schema.Attribute{
+			"spreadsheet_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID for the spreadsheet. It can be obtained from the URL.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"find": schema.StringAttribute{
+				MarkdownDescription: "The value to search",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"replacement": schema.StringAttribute{
+				MarkdownDescription: "The value to use as the replacement. With `search_by_regex`, it can reference capture groups such as `$1`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"range": schema.StringAttribute{
+				MarkdownDescription: "The range to search in A1 notation. Use the sheet title to point to a specific sheet. If neither `range` nor `sheet_id` are set, every sheet is searched.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("sheet_id")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sheet_id": schema.Int64Attribute{
+				MarkdownDescription: "The sheet to search",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"match_case":        option("Whether the search is case sensitive"),
+			"match_entire_cell": option("Whether the value must match the entire cell"),
+			"search_by_regex":   option("Whether `find` is a regular expression"),
+			"include_formulas":  option("Whether formulas are searched as well"),
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that run the replacement again when they change",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"occurrences_changed": count("The number of occurrences that were replaced"),
+			"rows_changed":        count("The number of rows that were changed"),
+			"sheets_changed":      count("The number of sheets that were changed"),
+		},
+	}
+}
+
+// Configure implements resource.ResourceWithConfigure.
+func (r *FindReplaceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sheets.Service)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Type",
+			fmt.Sprintf("Expected *sheets.Service, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create is called when the provider must create a new resource. Config
+// and planned state values should be read from the
+// CreateRequest and new state values set on the CreateResponse.
+func (r *FindReplaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FindReplaceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getRequest := r.client.Spreadsheets.Get(data.SpreadsheetID.ValueString())
+	getRequest.Fields("spreadsheetId,sheets.properties")
+	getRequest.Context(ctx)
+	spreadsheet, err := getRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read data,", err.Error())
+		return
+	}
+
+	findReplace, err := data.ToFindReplaceRequest(spreadsheet)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid range", err.Error())
+		return
+	}
+
+	updateRequest := r.client.Spreadsheets.BatchUpdate(data.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{{FindReplace: findReplace}},
+	})
+	updateRequest.Context(ctx)
+	res, err := updateRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to find and replace", err.Error())
+		return
+	}
+
+	reply := &sheets.FindReplaceResponse{}
+	if len(res.Replies) > 0 && res.Replies[0].FindReplace != nil {
+		reply = res.Replies[0].FindReplace
+	}
+	data.OccurrencesChanged = types.Int64Value(reply.OccurrencesChanged)
+	data.RowsChanged = types.Int64Value(reply.RowsChanged)
+	data.SheetsChanged = types.Int64Value(reply.SheetsChanged)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read is called when the provider must read resource values in order
+// to update state. Planned state values should be read from the
+// ReadRequest and new state values set on the ReadResponse.
+func (r *FindReplaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The replacement already happened, there is nothing to refresh.
+	var data FindReplaceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is called to update the state of the resource. Config, planned
+// state, and prior state values should be read from the
+// UpdateRequest and new state values set on the UpdateResponse.
+func (r *FindReplaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every attribute requires replace, there is nothing to update.
+	var data FindReplaceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete is called when the provider must delete the resource. Config
+// values may be read from the DeleteRequest.
+//
+// If execution completes without error, the framework will automatically
+// call DeleteResponse.State.RemoveResource(), so it can be omitted
+// from provider logic.
+func (r *FindReplaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The replacement can't be undone, the resource is only removed from the state.
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestAccFindReplaceResource(t *testing.T) {
+	var requests []*sheets.FindReplaceRequest
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v4/spreadsheets/{spreadsheetId}", func(w http.ResponseWriter, r *http.Request) {
+		res := sheets.Spreadsheet{
+			SpreadsheetId: r.PathValue("spreadsheetId"),
+			Sheets: []*sheets.Sheet{
+				{Properties: &sheets.SheetProperties{SheetId: 0, Title: "Sheet1"}},
+				{Properties: &sheets.SheetProperties{SheetId: 3, Title: "teams"}},
+			},
+		}
+		err := json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+	mux.HandleFunc("POST /v4/spreadsheets/{spreadsheetIdUpdate}", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		requestBody := &sheets.BatchUpdateSpreadsheetRequest{}
+		err := json.NewDecoder(r.Body).Decode(requestBody)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		findReplace := requestBody.Requests[0].FindReplace
+		if findReplace == nil {
+			t.Errorf("Unexpected request %v", requestBody.Requests[0])
+		}
+		requests = append(requests, findReplace)
+
+		err = json.NewEncoder(w).Encode(sheets.BatchUpdateSpreadsheetResponse{
+			Replies: []*sheets.Response{
+				{FindReplace: &sheets.FindReplaceResponse{OccurrencesChanged: 7, RowsChanged: 5, SheetsChanged: 2, ValuesChanged: 7}},
+			},
+		})
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	config := func(scope string, revision string) string {
+		return fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_find_replace" "test" {
+	spreadsheet_id = "test-spreadsheet-id"
+	find = "Platform"
+	replacement = "Infrastructure"
+	match_case = true
+	%s
+	triggers = {
+		revision = "%s"
+	}
+}
+`, server.URL, scope, revision)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		Steps: []resource.TestStep{
+			{
+				Config: config("", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_find_replace.test", "occurrences_changed", "7"),
+					resource.TestCheckResourceAttr("gsheets_find_replace.test", "rows_changed", "5"),
+					resource.TestCheckResourceAttr("gsheets_find_replace.test", "sheets_changed", "2"),
+					func(s *terraform.State) error {
+						request := requests[0]
+						if len(requests) != 1 || !request.AllSheets || request.Find != "Platform" || request.Replacement != "Infrastructure" || !request.MatchCase {
+							return fmt.Errorf("Unexpected requests %v", requests)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// The replacement only runs once.
+				Config:   config("", "1"),
+				PlanOnly: true,
+			},
+			{
+				// A new trigger runs it again, this time on a single sheet.
+				Config: config("sheet_id = 0", "2"),
+				Check: func(s *terraform.State) error {
+					request := requests[len(requests)-1]
+					if len(requests) != 2 || request.AllSheets || request.SheetId != 0 || request.Range != nil {
+						return fmt.Errorf("Unexpected requests %v", requests)
+					}
+					return nil
+				},
+			},
+			{
+				Config: config(`range = "'teams'!A2:A"`, "2"),
+				Check: func(s *terraform.State) error {
+					request := requests[len(requests)-1]
+					if len(requests) != 3 || request.AllSheets || request.Range.SheetId != 3 || request.Range.StartRowIndex != 1 || request.Range.EndColumnIndex != 1 {
+						return fmt.Errorf("Unexpected request %v", request)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
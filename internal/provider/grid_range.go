@@ -0,0 +1,302 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/api/sheets/v4"
+)
+
+// SheetNotFoundError is returned when a range references a sheet that doesn't exist, usually because it was deleted.
+type SheetNotFoundError struct {
+	// Sheet is the title or the id of the sheet.
+	Sheet         string
+	SpreadsheetID string
+}
+
+func (e *SheetNotFoundError) Error() string {
+	return fmt.Sprintf("sheet %s not found in spreadsheet %s", e.Sheet, e.SpreadsheetID)
+}
+
+var cellReferenceRegexp = regexp.MustCompile(`^\$?([A-Za-z]*)\$?([0-9]*)$`)
+
+// SplitA1 separates the sheet title from the cell references of a range in A1 notation.
+// Quoted titles are unquoted. If the range doesn't reference a sheet, title is empty.
+func SplitA1(a1 string) (title string, cells string, err error) {
+	if strings.HasPrefix(a1, "'") {
+		// Quotes inside quoted titles are escaped by doubling them.
+		i := 1
+		for {
+			end := strings.Index(a1[i:], "'")
+			if end == -1 {
+				return "", "", fmt.Errorf("unterminated quote in range %q", a1)
+			}
+			i += end + 1
+			if strings.HasPrefix(a1[i:], "'") {
+				i++
+				continue
+			}
+			break
+		}
+		title = strings.ReplaceAll(a1[1:i-1], "''", "'")
+		rest := a1[i:]
+		if rest == "" {
+			return title, "", nil
+		}
+		if !strings.HasPrefix(rest, "!") {
+			return "", "", fmt.Errorf("expected '!' after sheet title in range %q", a1)
+		}
+		return title, rest[1:], nil
+	}
+
+	if i := strings.LastIndex(a1, "!"); i != -1 {
+		return a1[:i], a1[i+1:], nil
+	}
+
+	// Without a separator, it is either a range in the first sheet or a sheet title.
+	if _, err := ParseCells(a1); err == nil {
+		return "", a1, nil
+	}
+	return a1, "", nil
+}
+
+// ParseCells converts the cell references of a range, such as A1:B2, A:C or 2:5, into a GridRange.
+// The sheet id is not set. Missing boundaries are left unbounded.
+func ParseCells(cells string) (*sheets.GridRange, error) {
+	gridRange := &sheets.GridRange{}
+	if cells == "" {
+		return gridRange, nil
+	}
+
+	parts := strings.Split(cells, ":")
+	if len(parts) > 2 {
+		return nil, fmt.Errorf("invalid range %q", cells)
+	}
+
+	startColumn, startRow, err := parseCellReference(parts[0])
+	if err != nil {
+		return nil, err
+	}
+	endColumn, endRow := startColumn, startRow
+	if len(parts) == 2 {
+		endColumn, endRow, err = parseCellReference(parts[1])
+		if err != nil {
+			return nil, err
+		}
+	} else if startColumn == 0 || startRow == 0 {
+		return nil, fmt.Errorf("invalid cell reference %q", cells)
+	}
+
+	if startColumn > 0 {
+		gridRange.StartColumnIndex = startColumn - 1
+	}
+	if startRow > 0 {
+		gridRange.StartRowIndex = startRow - 1
+	}
+	gridRange.EndColumnIndex = endColumn
+	gridRange.EndRowIndex = endRow
+
+	return gridRange, nil
+}
+
+// parseCellReference returns the 1 based column and row of a reference. Zero means the part is missing.
+func parseCellReference(reference string) (column int64, row int64, err error) {
+	match := cellReferenceRegexp.FindStringSubmatch(reference)
+	if match == nil || (match[1] == "" && match[2] == "") {
+		return 0, 0, fmt.Errorf("invalid cell reference %q", reference)
+	}
+
+	for _, letter := range strings.ToUpper(match[1]) {
+		column = column*26 + int64(letter-'A'+1)
+	}
+	if match[2] != "" {
+		row, err = strconv.ParseInt(match[2], 10, 64)
+		if err != nil || row == 0 {
+			return 0, 0, fmt.Errorf("invalid row in cell reference %q", reference)
+		}
+	}
+	return column, row, nil
+}
+
+// ColumnLetters returns the letters for the 0 based column index.
+func ColumnLetters(index int64) string {
+	letters := ""
+	for n := index + 1; n > 0; n = (n - 1) / 26 {
+		letters = string(rune('A'+(n-1)%26)) + letters
+	}
+	return letters
+}
+
+// FormatCells is the inverse of ParseCells.
+func FormatCells(gridRange *sheets.GridRange) string {
+	hasColumns := gridRange.StartColumnIndex > 0 || gridRange.EndColumnIndex > 0
+	hasRows := gridRange.StartRowIndex > 0 || gridRange.EndRowIndex > 0
+	if !hasColumns && !hasRows {
+		return ""
+	}
+
+	start, end := "", ""
+	if hasColumns {
+		start += ColumnLetters(gridRange.StartColumnIndex)
+		if gridRange.EndColumnIndex > 0 {
+			end += ColumnLetters(gridRange.EndColumnIndex - 1)
+		}
+	}
+	if hasRows {
+		start += strconv.FormatInt(gridRange.StartRowIndex+1, 10)
+		if gridRange.EndRowIndex > 0 {
+			end += strconv.FormatInt(gridRange.EndRowIndex, 10)
+		}
+	}
+	// A single cell is written without the end, like E2.
+	if hasColumns && hasRows && start == end {
+		return start
+	}
+	return start + ":" + end
+}
+
+// FormatA1 builds a range in A1 notation with the sheet title properly quoted.
+func FormatA1(title string, gridRange *sheets.GridRange) string {
+	quoted := "'" + strings.ReplaceAll(title, "'", "''") + "'"
+	cells := FormatCells(gridRange)
+	if cells == "" {
+		return quoted
+	}
+	return quoted + "!" + cells
+}
+
+// GridRangeFromA1 converts a range in A1 notation into a GridRange.
+// The sheet title is resolved into a sheet id using the sheets of the spreadsheet.
+// Ranges without a sheet title point to the first sheet.
+func GridRangeFromA1(spreadsheet *sheets.Spreadsheet, a1 string) (*sheets.GridRange, error) {
+	// A title like Sheet1 is also a valid cell reference, the sheet takes precedence.
+	if sheet := FindSheetByTitle(spreadsheet, a1); a1 != "" && sheet != nil {
+		return &sheets.GridRange{SheetId: sheet.Properties.SheetId}, nil
+	}
+
+	title, cells, err := SplitA1(a1)
+	if err != nil {
+		return nil, err
+	}
+
+	gridRange, err := ParseCells(cells)
+	if err != nil {
+		return nil, err
+	}
+
+	sheet := FindSheetByTitle(spreadsheet, title)
+	if sheet == nil {
+		return nil, &SheetNotFoundError{Sheet: strconv.Quote(title), SpreadsheetID: spreadsheet.SpreadsheetId}
+	}
+	gridRange.SheetId = sheet.Properties.SheetId
+
+	return gridRange, nil
+}
+
+// A1FromGridRange is the inverse of GridRangeFromA1.
+func A1FromGridRange(spreadsheet *sheets.Spreadsheet, gridRange *sheets.GridRange) (string, error) {
+	sheet := FindSheetByID(spreadsheet, gridRange.SheetId)
+	if sheet == nil {
+		return "", &SheetNotFoundError{Sheet: strconv.FormatInt(gridRange.SheetId, 10), SpreadsheetID: spreadsheet.SpreadsheetId}
+	}
+	return FormatA1(sheet.Properties.Title, gridRange), nil
+}
+
+// EqualGridRanges compares the position of two grid ranges.
+func EqualGridRanges(a, b *sheets.GridRange) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.SheetId == b.SheetId &&
+		a.StartRowIndex == b.StartRowIndex &&
+		a.EndRowIndex == b.EndRowIndex &&
+		a.StartColumnIndex == b.StartColumnIndex &&
+		a.EndColumnIndex == b.EndColumnIndex
+}
+
+// FindSheetByTitle returns the sheet with the given title. An empty title returns the first sheet.
+func FindSheetByTitle(spreadsheet *sheets.Spreadsheet, title string) *sheets.Sheet {
+	for _, sheet := range spreadsheet.Sheets {
+		if sheet.Properties == nil {
+			continue
+		}
+		if title == "" || sheet.Properties.Title == title {
+			return sheet
+		}
+	}
+	return nil
+}
+
+// FindSheetByID returns the sheet with the given id.
+func FindSheetByID(spreadsheet *sheets.Spreadsheet, sheetID int64) *sheets.Sheet {
+	for _, sheet := range spreadsheet.Sheets {
+		if sheet.Properties != nil && sheet.Properties.SheetId == sheetID {
+			return sheet
+		}
+	}
+	return nil
+}
+
+// RefreshA1 returns the current value when it still points to the given grid range.
+// Otherwise, it returns the grid range in A1 notation. It avoids diffs caused only by notation differences.
+func RefreshA1(spreadsheet *sheets.Spreadsheet, current types.String, gridRange *sheets.GridRange) (types.String, error) {
+	if !current.IsNull() && !current.IsUnknown() {
+		currentRange, err := GridRangeFromA1(spreadsheet, current.ValueString())
+		if err == nil && EqualGridRanges(currentRange, gridRange) {
+			return current, nil
+		}
+	}
+
+	a1, err := A1FromGridRange(spreadsheet, gridRange)
+	if err != nil {
+		return current, err
+	}
+	return types.StringValue(a1), nil
+}
+
+// CellAt returns the cell at the given position from the grid data of the sheet. It returns nil if it is not present.
+func CellAt(sheet *sheets.Sheet, row, column int64) *sheets.CellData {
+	for _, data := range sheet.Data {
+		i := row - data.StartRow
+		j := column - data.StartColumn
+		if i < 0 || j < 0 || i >= int64(len(data.RowData)) {
+			continue
+		}
+		values := data.RowData[i].Values
+		if j >= int64(len(values)) {
+			continue
+		}
+		return values[j]
+	}
+	return nil
+}
+
+// BoundGridRange returns a copy of the grid range where unbounded ends are limited by the size of the sheet.
+func BoundGridRange(sheet *sheets.Sheet, gridRange *sheets.GridRange) *sheets.GridRange {
+	bounded := *gridRange
+	if grid := sheet.Properties.GridProperties; grid != nil {
+		if bounded.EndRowIndex == 0 || bounded.EndRowIndex > grid.RowCount {
+			bounded.EndRowIndex = grid.RowCount
+		}
+		if bounded.EndColumnIndex == 0 || bounded.EndColumnIndex > grid.ColumnCount {
+			bounded.EndColumnIndex = grid.ColumnCount
+		}
+	}
+	return &bounded
+}
+
+// ForEachCell calls fn for every position of the grid range, even if the cell is not present in the grid data.
+// Unbounded ranges are limited by the size of the sheet. It stops as soon as fn returns false.
+func ForEachCell(sheet *sheets.Sheet, gridRange *sheets.GridRange, fn func(row, column int64, cell *sheets.CellData) bool) {
+	bounded := BoundGridRange(sheet, gridRange)
+	for row := bounded.StartRowIndex; row < bounded.EndRowIndex; row++ {
+		for column := bounded.StartColumnIndex; column < bounded.EndColumnIndex; column++ {
+			if !fn(row, column, CellAt(sheet, row, column)) {
+				return
+			}
+		}
+	}
+}
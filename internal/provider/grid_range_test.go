@@ -0,0 +1,233 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestSplitA1(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		expectedTitle string
+		expectedCells string
+		expectError   bool
+	}{
+		{
+			name:          "Quoted title",
+			input:         "'test title'!A:C",
+			expectedTitle: "test title",
+			expectedCells: "A:C",
+		},
+		{
+			name:          "Quoted title with escaped quote",
+			input:         "'it''s here'!A1",
+			expectedTitle: "it's here",
+			expectedCells: "A1",
+		},
+		{
+			name:          "Unquoted title",
+			input:         "Sheet1!A1:B2",
+			expectedTitle: "Sheet1",
+			expectedCells: "A1:B2",
+		},
+		{
+			name:          "Only cells",
+			input:         "A1:B2",
+			expectedTitle: "",
+			expectedCells: "A1:B2",
+		},
+		{
+			name:          "Only quoted title",
+			input:         "'my sheet'",
+			expectedTitle: "my sheet",
+			expectedCells: "",
+		},
+		{
+			name:          "Only unquoted title",
+			input:         "Roster",
+			expectedTitle: "Roster",
+			expectedCells: "",
+		},
+		{
+			name:        "Unterminated quote",
+			input:       "'my sheet!A1",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			title, cells, err := SplitA1(tt.input)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error for %q", tt.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error %s", err)
+			}
+			if title != tt.expectedTitle || cells != tt.expectedCells {
+				t.Errorf("Expected %q %q, got %q %q", tt.expectedTitle, tt.expectedCells, title, cells)
+			}
+		})
+	}
+}
+
+func TestParseCells(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expected    *sheets.GridRange
+		expectError bool
+	}{
+		{
+			name:     "Bounded range",
+			input:    "A1:C3",
+			expected: &sheets.GridRange{StartRowIndex: 0, EndRowIndex: 3, StartColumnIndex: 0, EndColumnIndex: 3},
+		},
+		{
+			name:     "Single cell",
+			input:    "B3",
+			expected: &sheets.GridRange{StartRowIndex: 2, EndRowIndex: 3, StartColumnIndex: 1, EndColumnIndex: 2},
+		},
+		{
+			name:     "Whole columns",
+			input:    "D:F",
+			expected: &sheets.GridRange{StartColumnIndex: 3, EndColumnIndex: 6},
+		},
+		{
+			name:     "Whole rows",
+			input:    "2:5",
+			expected: &sheets.GridRange{StartRowIndex: 1, EndRowIndex: 5},
+		},
+		{
+			name:     "Open ended rows",
+			input:    "A2:C",
+			expected: &sheets.GridRange{StartRowIndex: 1, StartColumnIndex: 0, EndColumnIndex: 3},
+		},
+		{
+			name:     "Absolute references and double letters",
+			input:    "$AA$1:$AB$2",
+			expected: &sheets.GridRange{StartRowIndex: 0, EndRowIndex: 2, StartColumnIndex: 26, EndColumnIndex: 28},
+		},
+		{
+			name:     "Empty means the whole sheet",
+			input:    "",
+			expected: &sheets.GridRange{},
+		},
+		{
+			name:        "Single column is not a cell",
+			input:       "A",
+			expectError: true,
+		},
+		{
+			name:        "Invalid characters",
+			input:       "A1:B-2",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseCells(tt.input)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error for %q", tt.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error %s", err)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %+v, got %+v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestFormatCells(t *testing.T) {
+	for _, input := range []string{"A1:C3", "D:F", "2:5", "A2:C", "AA1:AB2", "Z1:AA1", "E2", ""} {
+		t.Run(input, func(t *testing.T) {
+			gridRange, err := ParseCells(input)
+			if err != nil {
+				t.Fatalf("Unexpected error %s", err)
+			}
+			if result := FormatCells(gridRange); result != input {
+				t.Errorf("Expected %q, got %q", input, result)
+			}
+		})
+	}
+}
+
+func TestGridRangeFromA1(t *testing.T) {
+	spreadsheet := &sheets.Spreadsheet{
+		SpreadsheetId: "test-spreadsheet-id",
+		Sheets: []*sheets.Sheet{
+			{Properties: &sheets.SheetProperties{SheetId: 0, Title: "Sheet1"}},
+			{Properties: &sheets.SheetProperties{SheetId: 2, Title: "test title"}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		input       string
+		expected    *sheets.GridRange
+		expectError bool
+	}{
+		{
+			name:     "Range in a quoted sheet",
+			input:    "'test title'!A:C",
+			expected: &sheets.GridRange{SheetId: 2, StartColumnIndex: 0, EndColumnIndex: 3},
+		},
+		{
+			name:     "Range without sheet uses the first one",
+			input:    "B2:C3",
+			expected: &sheets.GridRange{SheetId: 0, StartRowIndex: 1, EndRowIndex: 3, StartColumnIndex: 1, EndColumnIndex: 3},
+		},
+		{
+			name:     "Sheet title that looks like a cell",
+			input:    "Sheet1",
+			expected: &sheets.GridRange{SheetId: 0},
+		},
+		{
+			name:        "Unknown sheet",
+			input:       "'missing'!A1",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := GridRangeFromA1(spreadsheet, tt.input)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error for %q", tt.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error %s", err)
+			}
+			if !EqualGridRanges(result, tt.expected) {
+				t.Errorf("Expected %+v, got %+v", tt.expected, result)
+			}
+
+			a1, err := A1FromGridRange(spreadsheet, result)
+			if err != nil {
+				t.Fatalf("Unexpected error %s", err)
+			}
+			back, err := GridRangeFromA1(spreadsheet, a1)
+			if err != nil {
+				t.Fatalf("Unexpected error %s", err)
+			}
+			if !EqualGridRanges(result, back) {
+				t.Errorf("Round trip through %q changed the range %+v to %+v", a1, result, back)
+			}
+		})
+	}
+}
@@ -0,0 +1,359 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/api/sheets/v4"
+)
+
+var _ resource.ResourceWithConfigure = &MergeResource{}
+
+func NewMergeResource() resource.Resource {
+	return &MergeResource{}
+}
+
+type MergeResource struct {
+	client *sheets.Service
+}
+
+type MergeResourceModel struct {
+	SpreadsheetID types.String    `tfsdk:"spreadsheet_id"`
+	Range         types.String    `tfsdk:"range"`
+	GridRange     *GridRangeModel `tfsdk:"grid_range"`
+	MergeType     types.String    `tfsdk:"merge_type"`
+}
+
+// GridRangeModel addresses a range by the position of its cells.
+type GridRangeModel struct {
+	SheetID          types.Int64 `tfsdk:"sheet_id"`
+	StartRowIndex    types.Int64 `tfsdk:"start_row_index"`
+	EndRowIndex      types.Int64 `tfsdk:"end_row_index"`
+	StartColumnIndex types.Int64 `tfsdk:"start_column_index"`
+	EndColumnIndex   types.Int64 `tfsdk:"end_column_index"`
+}
+
+func (m *GridRangeModel) ToGridRange() *sheets.GridRange {
+	return &sheets.GridRange{
+		SheetId:          m.SheetID.ValueInt64(),
+		StartRowIndex:    m.StartRowIndex.ValueInt64(),
+		EndRowIndex:      m.EndRowIndex.ValueInt64(),
+		StartColumnIndex: m.StartColumnIndex.ValueInt64(),
+		EndColumnIndex:   m.EndColumnIndex.ValueInt64(),
+	}
+}
+
+// ResolveGridRange resolves the range of the model, either from A1 notation or from grid coordinates.
+// Unbounded ranges are limited by the size of the sheet because merges are always bounded.
+func (m MergeResourceModel) ResolveGridRange(spreadsheet *sheets.Spreadsheet) (*sheets.GridRange, error) {
+	var gridRange *sheets.GridRange
+	if m.GridRange != nil {
+		gridRange = m.GridRange.ToGridRange()
+	} else {
+		var err error
+		gridRange, err = GridRangeFromA1(spreadsheet, m.Range.ValueString())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sheet := FindSheetByID(spreadsheet, gridRange.SheetId)
+	if sheet == nil {
+		return nil, &SheetNotFoundError{Sheet: strconv.FormatInt(gridRange.SheetId, 10), SpreadsheetID: spreadsheet.SpreadsheetId}
+	}
+	return BoundGridRange(sheet, gridRange), nil
+}
+
+// ExpectedMerges returns the merges that google sheets creates for the range and the merge type.
+func ExpectedMerges(gridRange *sheets.GridRange, mergeType string) []*sheets.GridRange {
+	var merges []*sheets.GridRange
+	switch mergeType {
+	case "MERGE_COLUMNS":
+		for column := gridRange.StartColumnIndex; column < gridRange.EndColumnIndex; column++ {
+			merge := *gridRange
+			merge.StartColumnIndex, merge.EndColumnIndex = column, column+1
+			merges = append(merges, &merge)
+		}
+	case "MERGE_ROWS":
+		for row := gridRange.StartRowIndex; row < gridRange.EndRowIndex; row++ {
+			merge := *gridRange
+			merge.StartRowIndex, merge.EndRowIndex = row, row+1
+			merges = append(merges, &merge)
+		}
+	default:
+		merges = append(merges, gridRange)
+	}
+	return merges
+}
+
+// ContainsMerges checks that every expected merge is in the merges of the sheet.
+func ContainsMerges(sheet *sheets.Sheet, expected []*sheets.GridRange) bool {
+	for _, merge := range expected {
+		found := false
+		for _, existing := range sheet.Merges {
+			if EqualGridRanges(merge, existing) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *MergeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_merge"
+}
+
+func (r *MergeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	index := func(description string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			MarkdownDescription: description,
+			Required:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Merges the cells of a range.
+
+If the cells are unmerged by hand, they are merged again. Only the value of the top left cell is kept when cells are merged.`,
+
+		Attributes: map[string]schema.Attribute{
+			"spreadsheet_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID for the spreadsheet. It can be obtained from the URL.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"range": schema.StringAttribute{
+				MarkdownDescription: "The range to merge in A1 notation. Use the sheet title to point to a specific sheet.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("grid_range")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"grid_range": schema.SingleNestedAttribute{
+				MarkdownDescription: "The range to merge as grid coordinates. Indexes start at 0 and end indexes are not included.",
+				Optional:            true,
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(path.MatchRoot("range")),
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"sheet_id":           index("The sheet that contains the range"),
+					"start_row_index":    index("The first row of the range"),
+					"end_row_index":      index("The row after the last row of the range"),
+					"start_column_index": index("The first column of the range"),
+					"end_column_index":   index("The column after the last column of the range"),
+				},
+			},
+			"merge_type": schema.StringAttribute{
+				MarkdownDescription: "How the cells are merged. `MERGE_ALL` creates a single merge, `MERGE_COLUMNS` merges each column and `MERGE_ROWS` merges each row. Defaults to `MERGE_ALL`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("MERGE_ALL"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("MERGE_ALL", "MERGE_COLUMNS", "MERGE_ROWS"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Configure implements resource.ResourceWithConfigure.
+func (r *MergeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sheets.Service)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Type",
+			fmt.Sprintf("Expected *sheets.Service, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create is called when the provider must create a new resource. Config
+// and planned state values should be read from the
+// CreateRequest and new state values set on the CreateResponse.
+func (r *MergeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MergeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spreadsheet, err := r.getSpreadsheet(ctx, data.SpreadsheetID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read data,", err.Error())
+		return
+	}
+
+	gridRange, err := data.ResolveGridRange(spreadsheet)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid range", err.Error())
+		return
+	}
+
+	err = r.update(ctx, data.SpreadsheetID.ValueString(), &sheets.Request{
+		MergeCells: &sheets.MergeCellsRequest{
+			Range:     gridRange,
+			MergeType: data.MergeType.ValueString(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to merge cells", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read is called when the provider must read resource values in order
+// to update state. Planned state values should be read from the
+// ReadRequest and new state values set on the ReadResponse.
+func (r *MergeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MergeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spreadsheet, err := r.getSpreadsheet(ctx, data.SpreadsheetID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read data,", err.Error())
+		return
+	}
+
+	gridRange, err := data.ResolveGridRange(spreadsheet)
+	var sheetNotFound *SheetNotFoundError
+	if errors.As(err, &sheetNotFound) {
+		// The merges were deleted along with the sheet.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid range", err.Error())
+		return
+	}
+
+	// If any of the merges is gone, the cells are merged again.
+	sheet := FindSheetByID(spreadsheet, gridRange.SheetId)
+	if !ContainsMerges(sheet, ExpectedMerges(gridRange, data.MergeType.ValueString())) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is called to update the state of the resource. Config, planned
+// state, and prior state values should be read from the
+// UpdateRequest and new state values set on the UpdateResponse.
+func (r *MergeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every attribute requires replace, there is nothing to update.
+	var data MergeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete is called when the provider must delete the resource. Config
+// values may be read from the DeleteRequest.
+//
+// If execution completes without error, the framework will automatically
+// call DeleteResponse.State.RemoveResource(), so it can be omitted
+// from provider logic.
+func (r *MergeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MergeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spreadsheet, err := r.getSpreadsheet(ctx, data.SpreadsheetID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read data,", err.Error())
+		return
+	}
+
+	gridRange, err := data.ResolveGridRange(spreadsheet)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid range", err.Error())
+		return
+	}
+
+	err = r.update(ctx, data.SpreadsheetID.ValueString(), &sheets.Request{
+		UnmergeCells: &sheets.UnmergeCellsRequest{
+			Range: gridRange,
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to unmerge cells", err.Error())
+		return
+	}
+}
+
+func (r *MergeResource) getSpreadsheet(ctx context.Context, spreadsheetID string) (*sheets.Spreadsheet, error) {
+	getRequest := r.client.Spreadsheets.Get(spreadsheetID)
+	getRequest.Fields("spreadsheetId,sheets(properties,merges)")
+	getRequest.Context(ctx)
+	return getRequest.Do()
+}
+
+func (r *MergeResource) update(ctx context.Context, spreadsheetID string, request *sheets.Request) error {
+	updateRequest := r.client.Spreadsheets.BatchUpdate(spreadsheetID, &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{request},
+	})
+	updateRequest.Context(ctx)
+	_, err := updateRequest.Do()
+	return err
+}
@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestAccMergeResource(t *testing.T) {
+	var lock sync.Mutex
+	var merges []*sheets.GridRange
+	sheetDeleted := false
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v4/spreadsheets/{spreadsheetId}", func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		res := sheets.Spreadsheet{
+			SpreadsheetId: r.PathValue("spreadsheetId"),
+			Sheets: []*sheets.Sheet{
+				{
+					Properties: &sheets.SheetProperties{
+						SheetId:        2,
+						Title:          "test title",
+						GridProperties: &sheets.GridProperties{RowCount: 100, ColumnCount: 26},
+					},
+					Merges: merges,
+				},
+			},
+		}
+		if sheetDeleted {
+			res.Sheets = []*sheets.Sheet{}
+		}
+		err := json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+	mux.HandleFunc("POST /v4/spreadsheets/{spreadsheetIdUpdate}", func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		defer r.Body.Close()
+		requestBody := &sheets.BatchUpdateSpreadsheetRequest{}
+		err := json.NewDecoder(r.Body).Decode(requestBody)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		request := requestBody.Requests[0]
+		switch {
+		case request.MergeCells != nil:
+			merges = append(merges, ExpectedMerges(request.MergeCells.Range, request.MergeCells.MergeType)...)
+		case request.UnmergeCells != nil:
+			var remaining []*sheets.GridRange
+			for _, merge := range merges {
+				unmerged := request.UnmergeCells.Range
+				inside := merge.SheetId == unmerged.SheetId &&
+					merge.StartRowIndex >= unmerged.StartRowIndex && merge.EndRowIndex <= unmerged.EndRowIndex &&
+					merge.StartColumnIndex >= unmerged.StartColumnIndex && merge.EndColumnIndex <= unmerged.EndColumnIndex
+				if !inside {
+					remaining = append(remaining, merge)
+				}
+			}
+			merges = remaining
+		default:
+			t.Errorf("Unexpected request %v", request)
+		}
+
+		res := sheets.BatchUpdateSpreadsheetResponse{
+			SpreadsheetId: strings.Split(r.PathValue("spreadsheetIdUpdate"), ":")[0],
+			Replies:       []*sheets.Response{{}},
+		}
+		err = json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	config := fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_merge" "title" {
+	spreadsheet_id = "test-spreadsheet-id"
+	range = "'test title'!A1:C1"
+}
+
+resource "gsheets_merge" "groups" {
+	spreadsheet_id = "test-spreadsheet-id"
+	grid_range = {
+		sheet_id = 2
+		start_row_index = 1
+		end_row_index = 3
+		start_column_index = 0
+		end_column_index = 2
+	}
+	merge_type = "MERGE_ROWS"
+}
+	`, server.URL)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_merge.title", "merge_type", "MERGE_ALL"),
+					resource.TestCheckResourceAttr("gsheets_merge.groups", "merge_type", "MERGE_ROWS"),
+					func(s *terraform.State) error {
+						if len(merges) != 3 {
+							return fmt.Errorf("Expected 3 merges, got %d", len(merges))
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// Someone unmerged the title by hand, it must be merged again.
+				PreConfig: func() {
+					var remaining []*sheets.GridRange
+					for _, merge := range merges {
+						if merge.StartRowIndex != 0 {
+							remaining = append(remaining, merge)
+						}
+					}
+					merges = remaining
+				},
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						if len(merges) != 3 {
+							return fmt.Errorf("Expected 3 merges, got %d", len(merges))
+						}
+						if !ContainsMerges(&sheets.Sheet{Merges: merges}, []*sheets.GridRange{{SheetId: 2, StartRowIndex: 0, EndRowIndex: 1, StartColumnIndex: 0, EndColumnIndex: 3}}) {
+							return fmt.Errorf("Expected the title to be merged again")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// The sheet was deleted by hand, along with its merges.
+				PreConfig: func() {
+					sheetDeleted = true
+					merges = nil
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+				Check: func(s *terraform.State) error {
+					if len(s.RootModule().Resources) != 0 {
+						return fmt.Errorf("Expected the merges to be removed from the state, got %v", s.RootModule().Resources)
+					}
+					return nil
+				},
+			},
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			if len(merges) != 0 {
+				return fmt.Errorf("Expected the cells to be unmerged, got %d merges", len(merges))
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,517 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/api/sheets/v4"
+)
+
+var _ resource.ResourceWithConfigure = &PivotTableResource{}
+
+func NewPivotTableResource() resource.Resource {
+	return &PivotTableResource{}
+}
+
+type PivotTableResource struct {
+	client *sheets.Service
+}
+
+type PivotTableResourceModel struct {
+	SpreadsheetID types.String           `tfsdk:"spreadsheet_id"`
+	AnchorCell    types.String           `tfsdk:"anchor_cell"`
+	Source        types.String           `tfsdk:"source"`
+	Rows          []PivotGroupModel      `tfsdk:"rows"`
+	Columns       []PivotGroupModel      `tfsdk:"columns"`
+	Values        []PivotValueModel      `tfsdk:"values"`
+	FilterSpecs   []PivotFilterSpecModel `tfsdk:"filter_specs"`
+	ValueLayout   types.String           `tfsdk:"value_layout"`
+}
+
+type PivotGroupModel struct {
+	SourceColumnOffset types.Int64  `tfsdk:"source_column_offset"`
+	SortOrder          types.String `tfsdk:"sort_order"`
+	ShowTotals         types.Bool   `tfsdk:"show_totals"`
+	Label              types.String `tfsdk:"label"`
+}
+
+type PivotValueModel struct {
+	SourceColumnOffset types.Int64  `tfsdk:"source_column_offset"`
+	SummarizeFunction  types.String `tfsdk:"summarize_function"`
+	Name               types.String `tfsdk:"name"`
+}
+
+type PivotFilterSpecModel struct {
+	SourceColumnOffset types.Int64            `tfsdk:"source_column_offset"`
+	Condition          *BooleanConditionModel `tfsdk:"condition"`
+	VisibleValues      []types.String         `tfsdk:"visible_values"`
+}
+
+func ToPivotGroups(models []PivotGroupModel) []*sheets.PivotGroup {
+	var groups []*sheets.PivotGroup
+	for _, m := range models {
+		groups = append(groups, &sheets.PivotGroup{
+			SourceColumnOffset: m.SourceColumnOffset.ValueInt64(),
+			SortOrder:          m.SortOrder.ValueString(),
+			ShowTotals:         m.ShowTotals.ValueBool(),
+			Label:              m.Label.ValueString(),
+			// The first column of the source has offset 0, which would be omitted otherwise.
+			ForceSendFields: []string{"SourceColumnOffset"},
+		})
+	}
+	return groups
+}
+
+func ToPivotValues(models []PivotValueModel) []*sheets.PivotValue {
+	var values []*sheets.PivotValue
+	for _, m := range models {
+		values = append(values, &sheets.PivotValue{
+			SourceColumnOffset: m.SourceColumnOffset.ValueInt64(),
+			SummarizeFunction:  m.SummarizeFunction.ValueString(),
+			Name:               m.Name.ValueString(),
+			ForceSendFields:    []string{"SourceColumnOffset"},
+		})
+	}
+	return values
+}
+
+func ToPivotFilterSpecs(models []PivotFilterSpecModel) []*sheets.PivotFilterSpec {
+	var specs []*sheets.PivotFilterSpec
+	for _, m := range models {
+		specs = append(specs, &sheets.PivotFilterSpec{
+			ColumnOffsetIndex: m.SourceColumnOffset.ValueInt64(),
+			FilterCriteria: &sheets.PivotFilterCriteria{
+				Condition:     m.Condition.ToBooleanCondition(),
+				VisibleValues: StringsFromValues(m.VisibleValues),
+			},
+			ForceSendFields: []string{"ColumnOffsetIndex"},
+		})
+	}
+	return specs
+}
+
+// NewPivotGroupModels is the inverse of ToPivotGroups.
+func NewPivotGroupModels(groups []*sheets.PivotGroup) []PivotGroupModel {
+	var models []PivotGroupModel
+	for _, group := range groups {
+		models = append(models, PivotGroupModel{
+			SourceColumnOffset: types.Int64Value(group.SourceColumnOffset),
+			SortOrder:          types.StringValue(group.SortOrder),
+			ShowTotals:         types.BoolValue(group.ShowTotals),
+			Label:              RefreshString(types.StringNull(), group.Label),
+		})
+	}
+	return models
+}
+
+// NewPivotValueModels is the inverse of ToPivotValues.
+func NewPivotValueModels(values []*sheets.PivotValue) []PivotValueModel {
+	var models []PivotValueModel
+	for _, value := range values {
+		models = append(models, PivotValueModel{
+			SourceColumnOffset: types.Int64Value(value.SourceColumnOffset),
+			SummarizeFunction:  types.StringValue(value.SummarizeFunction),
+			Name:               RefreshString(types.StringNull(), value.Name),
+		})
+	}
+	return models
+}
+
+// NewPivotFilterSpecModels is the inverse of ToPivotFilterSpecs.
+func NewPivotFilterSpecModels(specs []*sheets.PivotFilterSpec) []PivotFilterSpecModel {
+	var models []PivotFilterSpecModel
+	for _, spec := range specs {
+		m := PivotFilterSpecModel{
+			SourceColumnOffset: types.Int64Value(spec.ColumnOffsetIndex),
+		}
+		if spec.FilterCriteria != nil {
+			m.Condition = NewBooleanConditionModel(spec.FilterCriteria.Condition)
+			for _, value := range spec.FilterCriteria.VisibleValues {
+				m.VisibleValues = append(m.VisibleValues, types.StringValue(value))
+			}
+		}
+		models = append(models, m)
+	}
+	return models
+}
+
+func (m PivotTableResourceModel) ToPivotTable(spreadsheet *sheets.Spreadsheet) (*sheets.PivotTable, error) {
+	source, err := GridRangeFromA1(spreadsheet, m.Source.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	return &sheets.PivotTable{
+		Source:      source,
+		Rows:        ToPivotGroups(m.Rows),
+		Columns:     ToPivotGroups(m.Columns),
+		Values:      ToPivotValues(m.Values),
+		FilterSpecs: ToPivotFilterSpecs(m.FilterSpecs),
+		ValueLayout: m.ValueLayout.ValueString(),
+	}, nil
+}
+
+// Refresh updates the model with the pivot table returned by the API, keeping the current values if they are equivalent.
+func (m *PivotTableResourceModel) Refresh(spreadsheet *sheets.Spreadsheet, pivotTable *sheets.PivotTable) error {
+	var err error
+	if pivotTable.Source != nil {
+		m.Source, err = RefreshA1(spreadsheet, m.Source, pivotTable.Source)
+		if err != nil {
+			return err
+		}
+	}
+
+	if rows := NewPivotGroupModels(pivotTable.Rows); !jsonEqual(ToPivotGroups(m.Rows), ToPivotGroups(rows)) {
+		m.Rows = rows
+	}
+	if columns := NewPivotGroupModels(pivotTable.Columns); !jsonEqual(ToPivotGroups(m.Columns), ToPivotGroups(columns)) {
+		m.Columns = columns
+	}
+	if values := NewPivotValueModels(pivotTable.Values); !jsonEqual(ToPivotValues(m.Values), ToPivotValues(values)) {
+		m.Values = values
+	}
+	if specs := NewPivotFilterSpecModels(pivotTable.FilterSpecs); !jsonEqual(ToPivotFilterSpecs(m.FilterSpecs), ToPivotFilterSpecs(specs)) {
+		m.FilterSpecs = specs
+	}
+	// Google sheets fills in the layout, it is only managed when it is set.
+	if !m.ValueLayout.IsNull() {
+		m.ValueLayout = types.StringValue(pivotTable.ValueLayout)
+	}
+	return nil
+}
+
+// BuildRequest writes the pivot table into the anchor cell. A nil pivot table removes it.
+func (m PivotTableResourceModel) BuildRequest(spreadsheet *sheets.Spreadsheet, pivotTable *sheets.PivotTable) (*sheets.Request, error) {
+	anchor, err := GridRangeFromA1(spreadsheet, m.AnchorCell.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	return &sheets.Request{
+		UpdateCells: &sheets.UpdateCellsRequest{
+			Start: &sheets.GridCoordinate{
+				SheetId:         anchor.SheetId,
+				RowIndex:        anchor.StartRowIndex,
+				ColumnIndex:     anchor.StartColumnIndex,
+				ForceSendFields: []string{"SheetId", "RowIndex", "ColumnIndex"},
+			},
+			Rows: []*sheets.RowData{
+				{Values: []*sheets.CellData{{PivotTable: pivotTable}}},
+			},
+			Fields: "pivotTable",
+		},
+	}, nil
+}
+
+func (r *PivotTableResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pivot_table"
+}
+
+func (r *PivotTableResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	sourceColumnOffset := schema.Int64Attribute{
+		MarkdownDescription: "The column of the source range. The first column of the source has offset 0",
+		Required:            true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(0),
+		},
+	}
+	groups := func(description string) schema.ListNestedAttribute {
+		return schema.ListNestedAttribute{
+			MarkdownDescription: description,
+			Optional:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"source_column_offset": sourceColumnOffset,
+					"sort_order": schema.StringAttribute{
+						MarkdownDescription: "Either `ASCENDING` or `DESCENDING`. Defaults to `ASCENDING`",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("ASCENDING"),
+						Validators: []validator.String{
+							stringvalidator.OneOf("ASCENDING", "DESCENDING"),
+						},
+					},
+					"show_totals": schema.BoolAttribute{
+						MarkdownDescription: "Shows the totals of the group. Defaults to `true`",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(true),
+					},
+					"label": schema.StringAttribute{
+						MarkdownDescription: "The header of the group. If not set, the header of the source column is used",
+						Optional:            true,
+					},
+				},
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages a pivot table that summarizes a source range.
+
+The pivot table is written into the anchor cell and expands down and to the right of it.`,
+
+		Attributes: map[string]schema.Attribute{
+			"spreadsheet_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID for the spreadsheet. It can be obtained from the URL.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"anchor_cell": schema.StringAttribute{
+				MarkdownDescription: "The cell the pivot table is written into in A1 notation, like `'Summary'!A1`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "The range with the data to summarize in A1 notation, including the headers",
+				Required:            true,
+			},
+			"rows":    groups("The columns of the source whose values become the rows of the pivot table"),
+			"columns": groups("The columns of the source whose values become the columns of the pivot table"),
+			"values": schema.ListNestedAttribute{
+				MarkdownDescription: "The summarized values",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source_column_offset": sourceColumnOffset,
+						"summarize_function": schema.StringAttribute{
+							MarkdownDescription: "How the values are summarized, such as `SUM`, `COUNTA` or `AVERAGE`",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("SUM", "COUNTA", "COUNT", "COUNTUNIQUE", "AVERAGE", "MAX", "MIN", "MEDIAN", "PRODUCT", "STDEV", "STDEVP", "VAR", "VARP"),
+							},
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The header of the value",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"filter_specs": schema.ListNestedAttribute{
+				MarkdownDescription: "The criteria used to include rows of the source",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source_column_offset": sourceColumnOffset,
+						"condition":            booleanConditionAttribute("Only the rows that match the condition are included", false),
+						"visible_values": schema.ListAttribute{
+							MarkdownDescription: "Only the rows with any of these values in the column are included",
+							ElementType:         types.StringType,
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"value_layout": schema.StringAttribute{
+				MarkdownDescription: "Whether the values are laid out as columns, `HORIZONTAL`, or as rows, `VERTICAL`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("HORIZONTAL", "VERTICAL"),
+				},
+			},
+		},
+	}
+}
+
+// Configure implements resource.ResourceWithConfigure.
+func (r *PivotTableResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sheets.Service)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Type",
+			fmt.Sprintf("Expected *sheets.Service, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create is called when the provider must create a new resource. Config
+// and planned state values should be read from the
+// CreateRequest and new state values set on the CreateResponse.
+func (r *PivotTableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PivotTableResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.write(ctx, data, true)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create pivot table", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read is called when the provider must read resource values in order
+// to update state. Planned state values should be read from the
+// ReadRequest and new state values set on the ReadResponse.
+func (r *PivotTableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PivotTableResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The sheet is resolved first, requesting the grid data of a deleted sheet fails.
+	propertiesRequest := r.client.Spreadsheets.Get(data.SpreadsheetID.ValueString())
+	propertiesRequest.Fields("spreadsheetId,sheets.properties")
+	propertiesRequest.Context(ctx)
+	properties, err := propertiesRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read data,", err.Error())
+		return
+	}
+
+	_, err = GridRangeFromA1(properties, data.AnchorCell.ValueString())
+	var sheetNotFound *SheetNotFoundError
+	if errors.As(err, &sheetNotFound) {
+		// The pivot table was deleted along with the sheet.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid range", err.Error())
+		return
+	}
+
+	getRequest := r.client.Spreadsheets.Get(data.SpreadsheetID.ValueString())
+	getRequest.Ranges(data.AnchorCell.ValueString())
+	getRequest.IncludeGridData(true)
+	getRequest.Fields("spreadsheetId,sheets(properties,data(startRow,startColumn,rowData.values.pivotTable))")
+	getRequest.Context(ctx)
+	spreadsheet, err := getRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read data,", err.Error())
+		return
+	}
+
+	anchor, err := GridRangeFromA1(spreadsheet, data.AnchorCell.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid range", err.Error())
+		return
+	}
+
+	cell := CellAt(FindSheetByID(spreadsheet, anchor.SheetId), anchor.StartRowIndex, anchor.StartColumnIndex)
+	if cell == nil || cell.PivotTable == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	err = data.Refresh(spreadsheet, cell.PivotTable)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read pivot table", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is called to update the state of the resource. Config, planned
+// state, and prior state values should be read from the
+// UpdateRequest and new state values set on the UpdateResponse.
+func (r *PivotTableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PivotTableResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.write(ctx, data, true)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to perform update request", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete is called when the provider must delete the resource. Config
+// values may be read from the DeleteRequest.
+//
+// If execution completes without error, the framework will automatically
+// call DeleteResponse.State.RemoveResource(), so it can be omitted
+// from provider logic.
+func (r *PivotTableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PivotTableResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.write(ctx, data, false)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to delete pivot table", err.Error())
+		return
+	}
+}
+
+// write sets the pivot table of the anchor cell. It is removed when set is false.
+func (r *PivotTableResource) write(ctx context.Context, data PivotTableResourceModel, set bool) error {
+	getRequest := r.client.Spreadsheets.Get(data.SpreadsheetID.ValueString())
+	getRequest.Fields("spreadsheetId,sheets.properties")
+	getRequest.Context(ctx)
+	spreadsheet, err := getRequest.Do()
+	if err != nil {
+		return err
+	}
+
+	var pivotTable *sheets.PivotTable
+	if set {
+		pivotTable, err = data.ToPivotTable(spreadsheet)
+		if err != nil {
+			return err
+		}
+	}
+
+	request, err := data.BuildRequest(spreadsheet, pivotTable)
+	if err != nil {
+		return err
+	}
+
+	updateRequest := r.client.Spreadsheets.BatchUpdate(data.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{request},
+	})
+	updateRequest.Context(ctx)
+	_, err = updateRequest.Do()
+	return err
+}
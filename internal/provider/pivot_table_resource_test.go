@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestAccPivotTableResource(t *testing.T) {
+	var pivotTable *sheets.PivotTable
+	var start *sheets.GridCoordinate
+	summaryDeleted := false
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v4/spreadsheets/{spreadsheetId}", func(w http.ResponseWriter, r *http.Request) {
+		summary := &sheets.Sheet{Properties: &sheets.SheetProperties{SheetId: 3, Title: "summary"}}
+		if r.URL.Query().Get("includeGridData") == "true" {
+			if r.URL.Query().Get("ranges") != "'summary'!B2" {
+				t.Errorf("Unexpected ranges %s", r.URL.Query().Get("ranges"))
+			}
+			summary.Data = []*sheets.GridData{{
+				StartRow:    1,
+				StartColumn: 1,
+				RowData:     []*sheets.RowData{{Values: []*sheets.CellData{{PivotTable: pivotTable}}}},
+			}}
+		}
+		res := sheets.Spreadsheet{
+			SpreadsheetId: r.PathValue("spreadsheetId"),
+			Sheets: []*sheets.Sheet{
+				{Properties: &sheets.SheetProperties{SheetId: 2, Title: "roster"}},
+				summary,
+			},
+		}
+		if summaryDeleted {
+			// Ranges of a deleted sheet can't be parsed.
+			if r.URL.Query().Has("ranges") {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			res.Sheets = res.Sheets[:1]
+		}
+		err := json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+	mux.HandleFunc("POST /v4/spreadsheets/{spreadsheetIdUpdate}", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		requestBody := &sheets.BatchUpdateSpreadsheetRequest{}
+		err := json.NewDecoder(r.Body).Decode(requestBody)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		request := requestBody.Requests[0].UpdateCells
+		if request == nil || request.Fields != "pivotTable" {
+			t.Errorf("Unexpected request %v", requestBody.Requests[0])
+		} else {
+			start = request.Start
+			pivotTable = request.Rows[0].Values[0].PivotTable
+		}
+
+		res := sheets.BatchUpdateSpreadsheetResponse{
+			SpreadsheetId: strings.Split(r.PathValue("spreadsheetIdUpdate"), ":")[0],
+		}
+		err = json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	config := fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_pivot_table" "test" {
+	spreadsheet_id = "test-spreadsheet-id"
+	anchor_cell = "'summary'!B2"
+	source = "'roster'!A1:D100"
+	rows = [{
+		source_column_offset = 0
+	}]
+	columns = [{
+		source_column_offset = 1
+		sort_order = "DESCENDING"
+		show_totals = false
+	}]
+	values = [{
+		source_column_offset = 2
+		summarize_function = "COUNTA"
+		name = "People"
+	}]
+	filter_specs = [{
+		source_column_offset = 3
+		visible_values = ["active"]
+	}]
+}
+`, server.URL)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		CheckDestroy: func(s *terraform.State) error {
+			if pivotTable != nil {
+				return fmt.Errorf("Expected the pivot table to be removed")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_pivot_table.test", "rows.0.sort_order", "ASCENDING"),
+					resource.TestCheckResourceAttr("gsheets_pivot_table.test", "rows.0.show_totals", "true"),
+					resource.TestCheckNoResourceAttr("gsheets_pivot_table.test", "value_layout"),
+					func(s *terraform.State) error {
+						if start.SheetId != 3 || start.RowIndex != 1 || start.ColumnIndex != 1 {
+							return fmt.Errorf("Unexpected anchor %v", start)
+						}
+						if pivotTable.Source.SheetId != 2 || pivotTable.Source.EndRowIndex != 100 {
+							return fmt.Errorf("Unexpected source %v", pivotTable.Source)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// The pivot table was edited from the UI, it must be restored.
+				PreConfig: func() {
+					pivotTable.Values[0].SummarizeFunction = "SUM"
+					pivotTable.ValueLayout = "HORIZONTAL"
+				},
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_pivot_table.test", "values.0.summarize_function", "COUNTA"),
+					func(s *terraform.State) error {
+						if pivotTable.Values[0].SummarizeFunction != "COUNTA" {
+							return fmt.Errorf("Expected the pivot table to be restored")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// The pivot table was removed from the UI, it must be created again.
+				PreConfig: func() {
+					pivotTable = nil
+				},
+				Config: config,
+				Check: func(s *terraform.State) error {
+					if pivotTable == nil {
+						return fmt.Errorf("Expected the pivot table to be created again")
+					}
+					return nil
+				},
+			},
+			{
+				// The summary sheet was deleted by hand, along with the pivot table.
+				PreConfig: func() {
+					summaryDeleted = true
+					pivotTable = nil
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+				Check: func(s *terraform.State) error {
+					if len(s.RootModule().Resources) != 0 {
+						return fmt.Errorf("Expected the pivot table to be removed from the state, got %v", s.RootModule().Resources)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,468 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"google.golang.org/api/sheets/v4"
+)
+
+var _ resource.ResourceWithConfigure = &ProtectedRangeResource{}
+var _ resource.ResourceWithImportState = &ProtectedRangeResource{}
+
+func NewProtectedRangeResource() resource.Resource {
+	return &ProtectedRangeResource{}
+}
+
+type ProtectedRangeResource struct {
+	client *sheets.Service
+}
+
+type ProtectedRangeEditorsModel struct {
+	Users              []types.String `tfsdk:"users"`
+	Groups             []types.String `tfsdk:"groups"`
+	DomainUsersCanEdit types.Bool     `tfsdk:"domain_users_can_edit"`
+}
+
+type ProtectedRangeResourceModel struct {
+	SpreadsheetID     types.String                `tfsdk:"spreadsheet_id"`
+	ProtectedRangeID  types.Int64                 `tfsdk:"protected_range_id"`
+	Range             types.String                `tfsdk:"range"`
+	NamedRangeID      types.String                `tfsdk:"named_range_id"`
+	Description       types.String                `tfsdk:"description"`
+	WarningOnly       types.Bool                  `tfsdk:"warning_only"`
+	Editors           *ProtectedRangeEditorsModel `tfsdk:"editors"`
+	UnprotectedRanges []types.String              `tfsdk:"unprotected_ranges"`
+}
+
+// ToEditors converts the model into the API representation. It returns nil when there is no model.
+func (m *ProtectedRangeEditorsModel) ToEditors() *sheets.Editors {
+	if m == nil {
+		return nil
+	}
+	return &sheets.Editors{
+		Users:              StringsFromValues(m.Users),
+		Groups:             StringsFromValues(m.Groups),
+		DomainUsersCanEdit: m.DomainUsersCanEdit.ValueBool(),
+	}
+}
+
+// Refresh updates the model with the editors returned by the API.
+// The spreadsheet owner and the account used by the provider are always editors,
+// so only the users and groups that are managed by terraform are compared.
+func (m *ProtectedRangeEditorsModel) Refresh(editors *sheets.Editors) {
+	if m == nil {
+		return
+	}
+	if editors == nil {
+		editors = &sheets.Editors{}
+	}
+	m.Users = keepPresent(m.Users, editors.Users)
+	m.Groups = keepPresent(m.Groups, editors.Groups)
+	if !m.DomainUsersCanEdit.IsNull() || editors.DomainUsersCanEdit {
+		m.DomainUsersCanEdit = types.BoolValue(editors.DomainUsersCanEdit)
+	}
+}
+
+// keepPresent removes the values that are not present in the actual list.
+func keepPresent(values []types.String, actual []string) []types.String {
+	if values == nil {
+		return nil
+	}
+	result := []types.String{}
+	for _, value := range values {
+		if slices.Contains(actual, value.ValueString()) {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+// ToProtectedRange builds the API representation, resolving the A1 ranges with the given spreadsheet.
+func (m ProtectedRangeResourceModel) ToProtectedRange(spreadsheet *sheets.Spreadsheet) (*sheets.ProtectedRange, error) {
+	protectedRange := &sheets.ProtectedRange{
+		ProtectedRangeId: m.ProtectedRangeID.ValueInt64(),
+		NamedRangeId:     m.NamedRangeID.ValueString(),
+		Description:      m.Description.ValueString(),
+		WarningOnly:      m.WarningOnly.ValueBool(),
+		Editors:          m.Editors.ToEditors(),
+	}
+
+	if !m.Range.IsNull() {
+		gridRange, err := GridRangeFromA1(spreadsheet, m.Range.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		protectedRange.Range = gridRange
+	}
+
+	for _, unprotected := range m.UnprotectedRanges {
+		gridRange, err := GridRangeFromA1(spreadsheet, unprotected.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		protectedRange.UnprotectedRanges = append(protectedRange.UnprotectedRanges, gridRange)
+	}
+
+	return protectedRange, nil
+}
+
+// Fields returns the field mask for the attributes managed by the model.
+func (m ProtectedRangeResourceModel) Fields() string {
+	fields := []string{"description", "warningOnly", "unprotectedRanges"}
+	if m.NamedRangeID.IsNull() {
+		fields = append(fields, "range")
+	} else {
+		fields = append(fields, "namedRangeId")
+	}
+	// Without editors, the protected range is reset to the default ones. Warning only ranges can't have editors.
+	if !m.WarningOnly.ValueBool() {
+		fields = append(fields, "editors")
+	}
+	return strings.Join(fields, ",")
+}
+
+// Refresh updates the model with the protected range returned by the API.
+func (m *ProtectedRangeResourceModel) Refresh(spreadsheet *sheets.Spreadsheet, protectedRange *sheets.ProtectedRange) error {
+	m.ProtectedRangeID = types.Int64Value(protectedRange.ProtectedRangeId)
+	m.WarningOnly = types.BoolValue(protectedRange.WarningOnly)
+	if !m.Description.IsNull() || protectedRange.Description != "" {
+		m.Description = types.StringValue(protectedRange.Description)
+	}
+
+	if m.NamedRangeID.IsNull() {
+		refreshed, err := RefreshA1(spreadsheet, m.Range, protectedRange.Range)
+		if err != nil {
+			return err
+		}
+		m.Range = refreshed
+	} else {
+		m.NamedRangeID = types.StringValue(protectedRange.NamedRangeId)
+	}
+
+	if m.UnprotectedRanges != nil || len(protectedRange.UnprotectedRanges) > 0 {
+		unprotectedRanges := []types.String{}
+		for i, gridRange := range protectedRange.UnprotectedRanges {
+			current := types.StringNull()
+			if i < len(m.UnprotectedRanges) {
+				current = m.UnprotectedRanges[i]
+			}
+			refreshed, err := RefreshA1(spreadsheet, current, gridRange)
+			if err != nil {
+				return err
+			}
+			unprotectedRanges = append(unprotectedRanges, refreshed)
+		}
+		m.UnprotectedRanges = unprotectedRanges
+	}
+
+	m.Editors.Refresh(protectedRange.Editors)
+	return nil
+}
+
+func (r *ProtectedRangeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_protected_range"
+}
+
+func (r *ProtectedRangeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Protects a range of cells so only the given editors can modify them.
+
+This is useful to prevent humans from editing the cells managed by a ` + "`gsheets_range`" + `, its range can be used directly.`,
+
+		Attributes: map[string]schema.Attribute{
+			"spreadsheet_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID for the spreadsheet. It can be obtained from the URL.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"protected_range_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the protected range assigned by google sheets",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"range": schema.StringAttribute{
+				MarkdownDescription: "The range to protect in A1 notation. Use the sheet title to point to a specific sheet.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("named_range_id")),
+				},
+			},
+			"named_range_id": schema.StringAttribute{
+				MarkdownDescription: "The named range to protect, instead of a range.",
+				Optional:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the protected range",
+				Optional:            true,
+			},
+			"warning_only": schema.BoolAttribute{
+				MarkdownDescription: "Allows everyone to edit the range, showing a warning before doing it. It can't be combined with `editors`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"editors": protectedRangeEditorsAttribute(),
+			"unprotected_ranges": schema.ListAttribute{
+				MarkdownDescription: "Ranges in A1 notation within the protected range that can be edited by anyone",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// protectedRangeEditorsAttribute is shared by all the resources that can protect ranges.
+func protectedRangeEditorsAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "The users and groups with edit access to the protected range. The spreadsheet owner and the account used by the provider are always editors.",
+		Optional:            true,
+		Validators: []validator.Object{
+			objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("warning_only")),
+		},
+		Attributes: map[string]schema.Attribute{
+			"users": schema.SetAttribute{
+				MarkdownDescription: "The email addresses of the users with edit access",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"groups": schema.SetAttribute{
+				MarkdownDescription: "The email addresses of the groups with edit access",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"domain_users_can_edit": schema.BoolAttribute{
+				MarkdownDescription: "True if anyone in the document's domain has edit access",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// Configure implements resource.ResourceWithConfigure.
+func (r *ProtectedRangeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sheets.Service)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Type",
+			fmt.Sprintf("Expected *sheets.Service, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create is called when the provider must create a new resource. Config
+// and planned state values should be read from the
+// CreateRequest and new state values set on the CreateResponse.
+func (r *ProtectedRangeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProtectedRangeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getRequest := r.client.Spreadsheets.Get(data.SpreadsheetID.ValueString())
+	getRequest.Fields("spreadsheetId,sheets.properties")
+	getRequest.Context(ctx)
+	spreadsheet, err := getRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read spreadsheet", err.Error())
+		return
+	}
+
+	protectedRange, err := data.ToProtectedRange(spreadsheet)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid range", err.Error())
+		return
+	}
+
+	createRequest := r.client.Spreadsheets.BatchUpdate(data.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{AddProtectedRange: &sheets.AddProtectedRangeRequest{
+				ProtectedRange: protectedRange,
+			}},
+		},
+	})
+	createRequest.Context(ctx)
+	createResponse, err := createRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create protected range", err.Error())
+		return
+	}
+
+	data.ProtectedRangeID = basetypes.NewInt64Value(createResponse.Replies[0].AddProtectedRange.ProtectedRange.ProtectedRangeId)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (r *ProtectedRangeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ":", 2)
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError("ID is not correct", "The ID must be a <spreadsheet_id>:<protected_range_id>, but it was "+req.ID)
+		return
+	}
+
+	protectedRangeID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("ID is not correct", "The protected range ID must be a number, but it was "+parts[1])
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("spreadsheet_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("protected_range_id"), protectedRangeID)...)
+}
+
+// Read is called when the provider must read resource values in order
+// to update state. Planned state values should be read from the
+// ReadRequest and new state values set on the ReadResponse.
+func (r *ProtectedRangeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProtectedRangeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getRequest := r.client.Spreadsheets.Get(data.SpreadsheetID.ValueString())
+	getRequest.Fields("spreadsheetId,sheets(properties,protectedRanges)")
+	getRequest.Context(ctx)
+	spreadsheet, err := getRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read data,", err.Error())
+		return
+	}
+
+	protectedRange := FindProtectedRange(spreadsheet, data.ProtectedRangeID.ValueInt64())
+	if protectedRange == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	err = data.Refresh(spreadsheet, protectedRange)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read protected range", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// FindProtectedRange looks for the protected range with the given id in all the sheets.
+func FindProtectedRange(spreadsheet *sheets.Spreadsheet, protectedRangeID int64) *sheets.ProtectedRange {
+	for _, sheet := range spreadsheet.Sheets {
+		for _, protectedRange := range sheet.ProtectedRanges {
+			if protectedRange.ProtectedRangeId == protectedRangeID {
+				return protectedRange
+			}
+		}
+	}
+	return nil
+}
+
+// Update is called to update the state of the resource. Config, planned
+// state, and prior state values should be read from the
+// UpdateRequest and new state values set on the UpdateResponse.
+func (r *ProtectedRangeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ProtectedRangeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getRequest := r.client.Spreadsheets.Get(data.SpreadsheetID.ValueString())
+	getRequest.Fields("spreadsheetId,sheets.properties")
+	getRequest.Context(ctx)
+	spreadsheet, err := getRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read spreadsheet", err.Error())
+		return
+	}
+
+	protectedRange, err := data.ToProtectedRange(spreadsheet)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid range", err.Error())
+		return
+	}
+
+	updateRequest := r.client.Spreadsheets.BatchUpdate(data.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{UpdateProtectedRange: &sheets.UpdateProtectedRangeRequest{
+				ProtectedRange: protectedRange,
+				Fields:         data.Fields(),
+			}},
+		},
+	})
+	updateRequest.Context(ctx)
+	_, err = updateRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to perform update request", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete is called when the provider must delete the resource. Config
+// values may be read from the DeleteRequest.
+//
+// If execution completes without error, the framework will automatically
+// call DeleteResponse.State.RemoveResource(), so it can be omitted
+// from provider logic.
+func (r *ProtectedRangeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProtectedRangeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteRequest := r.client.Spreadsheets.BatchUpdate(data.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{DeleteProtectedRange: &sheets.DeleteProtectedRangeRequest{
+				ProtectedRangeId: data.ProtectedRangeID.ValueInt64(),
+			}},
+		},
+	})
+	deleteRequest.Context(ctx)
+	_, err := deleteRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to delete protected range", err.Error())
+		return
+	}
+}
@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestAccProtectedRangeResource(t *testing.T) {
+	var protectedRanges []*sheets.ProtectedRange
+	var fields string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v4/spreadsheets/{spreadsheetId}", func(w http.ResponseWriter, r *http.Request) {
+		res := sheets.Spreadsheet{
+			SpreadsheetId: r.PathValue("spreadsheetId"),
+			Sheets: []*sheets.Sheet{
+				{
+					Properties:      &sheets.SheetProperties{SheetId: 2, Title: "test title"},
+					ProtectedRanges: protectedRanges,
+				},
+			},
+		}
+		err := json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+	mux.HandleFunc("POST /v4/spreadsheets/{spreadsheetIdUpdate}", func(w http.ResponseWriter, r *http.Request) {
+		spreadsheetID := strings.Split(r.PathValue("spreadsheetIdUpdate"), ":")[0]
+		defer r.Body.Close()
+		requestBody := &sheets.BatchUpdateSpreadsheetRequest{}
+		err := json.NewDecoder(r.Body).Decode(requestBody)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		res := sheets.BatchUpdateSpreadsheetResponse{
+			SpreadsheetId: spreadsheetID,
+		}
+		request := requestBody.Requests[0]
+		switch {
+		case request.AddProtectedRange != nil:
+			protectedRange := request.AddProtectedRange.ProtectedRange
+			protectedRange.ProtectedRangeId = 10
+			// The account used by the provider is always an editor
+			if protectedRange.Editors != nil {
+				protectedRange.Editors.Users = append(protectedRange.Editors.Users, "provider@example.com")
+			}
+			protectedRanges = []*sheets.ProtectedRange{protectedRange}
+			res.Replies = []*sheets.Response{
+				{AddProtectedRange: &sheets.AddProtectedRangeResponse{ProtectedRange: protectedRange}},
+			}
+		case request.UpdateProtectedRange != nil:
+			protectedRange := request.UpdateProtectedRange.ProtectedRange
+			fields = request.UpdateProtectedRange.Fields
+			if protectedRange.Editors != nil {
+				protectedRange.Editors.Users = append(protectedRange.Editors.Users, "provider@example.com")
+			}
+			protectedRanges = []*sheets.ProtectedRange{protectedRange}
+			res.Replies = []*sheets.Response{{}}
+		case request.DeleteProtectedRange != nil:
+			protectedRanges = nil
+			res.Replies = []*sheets.Response{{}}
+		}
+
+		err = json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		Steps: []resource.TestStep{
+			{
+				// Google sheets rejects editors on ranges that only show a warning.
+				Config: `
+resource "gsheets_protected_range" "test" {
+	spreadsheet_id = "test-spreadsheet-id"
+	range = "'test title'!A:C"
+	warning_only = true
+	editors = {
+		users = ["admin@example.com"]
+	}
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_protected_range" "test" {
+	spreadsheet_id = "test-spreadsheet-id"
+	range = "'test title'!A:C"
+	description = "managed by terraform"
+	editors = {
+		users = ["admin@example.com"]
+	}
+}
+	`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_protected_range.test", "protected_range_id", "10"),
+					resource.TestCheckResourceAttr("gsheets_protected_range.test", "range", "'test title'!A:C"),
+					resource.TestCheckResourceAttr("gsheets_protected_range.test", "warning_only", "false"),
+					resource.TestCheckResourceAttr("gsheets_protected_range.test", "editors.users.#", "1"),
+					resource.TestCheckResourceAttr("gsheets_protected_range.test", "editors.users.0", "admin@example.com"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_protected_range" "test" {
+	spreadsheet_id = "test-spreadsheet-id"
+	range = "'test title'!A1:C10"
+	description = "managed by terraform"
+	editors = {
+		users = ["admin@example.com"]
+		groups = ["team@example.com"]
+	}
+	unprotected_ranges = ["'test title'!C2:C10"]
+}
+	`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_protected_range.test", "protected_range_id", "10"),
+					resource.TestCheckResourceAttr("gsheets_protected_range.test", "range", "'test title'!A1:C10"),
+					resource.TestCheckResourceAttr("gsheets_protected_range.test", "editors.groups.#", "1"),
+					resource.TestCheckResourceAttr("gsheets_protected_range.test", "unprotected_ranges.#", "1"),
+					resource.TestCheckResourceAttr("gsheets_protected_range.test", "unprotected_ranges.0", "'test title'!C2:C10"),
+					func(s *terraform.State) error {
+						if fields != "description,warningOnly,unprotectedRanges,range,editors" {
+							return fmt.Errorf("Unexpected fields %s", fields)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// Removing the editors resets them to the default ones.
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_protected_range" "test" {
+	spreadsheet_id = "test-spreadsheet-id"
+	range = "'test title'!A1:C10"
+	description = "managed by terraform"
+	unprotected_ranges = ["'test title'!C2:C10"]
+}
+	`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("gsheets_protected_range.test", "editors"),
+					func(s *terraform.State) error {
+						if fields != "description,warningOnly,unprotectedRanges,range,editors" {
+							return fmt.Errorf("Expected the editors to be updated, got fields %s", fields)
+						}
+						if protectedRanges[0].Editors != nil {
+							return fmt.Errorf("Expected the editors to be removed, got %v", protectedRanges[0].Editors)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_protected_range" "test" {
+	spreadsheet_id = "test-spreadsheet-id"
+	range = "'test title'!A1:C10"
+	description = "managed by terraform"
+	warning_only = true
+	unprotected_ranges = ["'test title'!C2:C10"]
+}
+	`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_protected_range.test", "warning_only", "true"),
+					func(s *terraform.State) error {
+						if fields != "description,warningOnly,unprotectedRanges,range" {
+							return fmt.Errorf("Expected the editors to be left out, got fields %s", fields)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:                         "gsheets_protected_range.test",
+				ImportState:                          true,
+				ImportStateId:                        "test-spreadsheet-id:10",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIgnore:              []string{"editors"},
+				ImportStateVerifyIdentifierAttribute: "protected_range_id",
+			},
+		},
+	})
+}
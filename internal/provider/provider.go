@@ -3,16 +3,25 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"os"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
 	"golang.org/x/oauth2/jwt"
 	"google.golang.org/api/option"
 	"google.golang.org/api/sheets/v4"
@@ -21,6 +30,7 @@ import (
 // Ensure Provider satisfies various provider interfaces.
 var _ provider.Provider = &GoogleSheetsProvider{}
 var _ provider.ProviderWithFunctions = &GoogleSheetsProvider{}
+var _ provider.ProviderWithEphemeralResources = &GoogleSheetsProvider{}
 
 // GoogleSheetsProvider defines the provider implementation.
 type GoogleSheetsProvider struct {
@@ -32,10 +42,18 @@ type GoogleSheetsProvider struct {
 
 // GoogleSheetsProviderModel describes the provider data model.
 type GoogleSheetsProviderModel struct {
-	ServiceAccountKey types.String `tfsdk:"service_account_key"`
-	Endpoint          types.String `tfsdk:"endpoint"`
+	AccessToken       types.String   `tfsdk:"access_token"`
+	ServiceAccountKey types.String   `tfsdk:"service_account_key"`
+	OAuthClientID     types.String   `tfsdk:"oauth_client_id"`
+	OAuthClientSecret types.String   `tfsdk:"oauth_client_secret"`
+	RefreshToken      types.String   `tfsdk:"refresh_token"`
+	Scopes            []types.String `tfsdk:"scopes"`
+	Endpoint          types.String   `tfsdk:"endpoint"`
 }
 
+// userTokenURL is where the refresh tokens of user credentials are exchanged, tests replace it with a mock server.
+var userTokenURL = google.Endpoint.TokenURL
+
 func (p *GoogleSheetsProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "gsheets"
 	resp.Version = p.version
@@ -44,9 +62,48 @@ func (p *GoogleSheetsProvider) Metadata(ctx context.Context, req provider.Metada
 func (p *GoogleSheetsProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"access_token": schema.StringAttribute{
+				MarkdownDescription: "An OAuth access token, such as one obtained with workload identity federation. It takes precedence over the other credentials. It can also be set with the `GSHEETS_ACCESS_TOKEN` environment variable",
+				Optional:            true,
+				Sensitive:           true,
+			},
 			"service_account_key": schema.StringAttribute{
 				MarkdownDescription: "The Google Sheet ID",
 				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("refresh_token")),
+				},
+			},
+			"oauth_client_id": schema.StringAttribute{
+				MarkdownDescription: "The client ID of the OAuth application that issued `refresh_token`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("oauth_client_secret"), path.MatchRoot("refresh_token")),
+				},
+			},
+			"oauth_client_secret": schema.StringAttribute{
+				MarkdownDescription: "The client secret of the OAuth application that issued `refresh_token`",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("oauth_client_id"), path.MatchRoot("refresh_token")),
+				},
+			},
+			"refresh_token": schema.StringAttribute{
+				MarkdownDescription: "The refresh token of a user, to act as that user instead of a service account. It is the `refresh_token` of an `authorized_user` JSON file, such as the one created by `gcloud auth application-default login`",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("oauth_client_id"), path.MatchRoot("oauth_client_secret")),
+				},
+			},
+			"scopes": schema.ListAttribute{
+				MarkdownDescription: "The OAuth scopes requested with `service_account_key`, such as `https://www.googleapis.com/auth/drive.file` to only reach the files created or opened by the application. They take precedence over the scopes embedded in the key. If neither are set, reads request `https://www.googleapis.com/auth/spreadsheets.readonly` and only writes request `https://www.googleapis.com/auth/spreadsheets`. They have no effect with `access_token` or `refresh_token`, whose tokens already have their scopes",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
 			},
 			"endpoint": schema.StringAttribute{
 				MarkdownDescription: "The Google Sheet Endpoint, replace this to run tests with a mock server",
@@ -56,6 +113,28 @@ func (p *GoogleSheetsProvider) Schema(ctx context.Context, req provider.SchemaRe
 	}
 }
 
+// EphemeralResourceData is passed to the ephemeral resources, which need the credentials besides the client.
+type EphemeralResourceData struct {
+	Client *sheets.Service
+	// TokenSource mints tokens with the credentials of the provider. Nil scopes are the scopes of the provider.
+	// It is nil when the provider has no credentials.
+	TokenSource func(ctx context.Context, scopes []string) oauth2.TokenSource
+}
+
+// accessTokenTransport explains the errors caused by an expired access token, which google only reports as unauthorized.
+type accessTokenTransport struct {
+	base http.RoundTripper
+}
+
+func (t *accessTokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := t.base.RoundTrip(req)
+	if err != nil || res.StatusCode != http.StatusUnauthorized {
+		return res, err
+	}
+	res.Body.Close()
+	return nil, errors.New("the access token was rejected, it has probably expired: provide a new one with access_token or GSHEETS_ACCESS_TOKEN")
+}
+
 type CredentialsFile struct {
 	Email string `json:"email"`
 	// Type                    string   `json:"type"`
@@ -81,8 +160,31 @@ func (p *GoogleSheetsProvider) Configure(ctx context.Context, req provider.Confi
 	}
 
 	opt := []option.ClientOption{}
+	var tokenSource func(ctx context.Context, scopes []string) oauth2.TokenSource
 
-	if !data.ServiceAccountKey.IsNull() {
+	accessToken := data.AccessToken.ValueString()
+	if data.AccessToken.IsNull() {
+		accessToken = os.Getenv("GSHEETS_ACCESS_TOKEN")
+	}
+
+	if accessToken != "" {
+		if data.Scopes != nil {
+			resp.Diagnostics.AddAttributeWarning(path.Root("scopes"), "Scopes are ignored", "The access token already has its scopes, scopes only applies to service_account_key.")
+		}
+
+		staticTokens := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: accessToken})
+		opt = append(opt, option.WithHTTPClient(&http.Client{
+			Transport: &oauth2.Transport{
+				Source: staticTokens,
+				Base:   &accessTokenTransport{base: &insufficientScopeTransport{base: http.DefaultTransport}},
+			},
+		}))
+
+		// The token was minted elsewhere, it can only be passed along.
+		tokenSource = func(ctx context.Context, scopes []string) oauth2.TokenSource {
+			return staticTokens
+		}
+	} else if !data.ServiceAccountKey.IsNull() {
 		credentials := &CredentialsFile{}
 
 		err := json.Unmarshal([]byte(data.ServiceAccountKey.ValueString()), credentials)
@@ -121,8 +223,71 @@ func (p *GoogleSheetsProvider) Configure(ctx context.Context, req provider.Confi
 
 		tflog.Info(ctx, fmt.Sprint(conf))
 
-		client := conf.Client(ctx)
-		opt = append(opt, option.WithHTTPClient(client))
+		// The scopes of the provider take precedence over the ones embedded in the key.
+		scopes := credentials.Scopes
+		if data.Scopes != nil {
+			scopes = nil
+			for _, scope := range data.Scopes {
+				scopes = append(scopes, scope.ValueString())
+			}
+		}
+
+		transport := func(scopes []string) http.RoundTripper {
+			// The configuration is copied, so every transport keeps its own scopes.
+			tokenConf := *conf
+			tokenConf.Scopes = scopes
+			return &oauth2.Transport{
+				Source: tokenConf.TokenSource(ctx),
+				Base:   &insufficientScopeTransport{base: http.DefaultTransport},
+			}
+		}
+		if len(scopes) > 0 {
+			opt = append(opt, option.WithHTTPClient(&http.Client{Transport: transport(scopes)}))
+		} else {
+			// Without scopes, reads don't request more than they need.
+			opt = append(opt, option.WithHTTPClient(&http.Client{Transport: &scopedTransport{
+				read:  transport([]string{ScopeSpreadsheetsReadOnly}),
+				write: transport([]string{ScopeSpreadsheets}),
+			}}))
+		}
+
+		tokenSource = func(ctx context.Context, requested []string) oauth2.TokenSource {
+			tokenConf := *conf
+			tokenConf.Scopes = requested
+			if requested == nil {
+				tokenConf.Scopes = DefaultAccessTokenScopes
+				if len(scopes) > 0 {
+					tokenConf.Scopes = scopes
+				}
+			}
+			return tokenConf.TokenSource(ctx)
+		}
+	} else if !data.RefreshToken.IsNull() {
+		if data.Scopes != nil {
+			resp.Diagnostics.AddAttributeWarning(path.Root("scopes"), "Scopes are ignored", "The tokens of a user have the scopes the user consented to, scopes only applies to service_account_key.")
+		}
+
+		conf := &oauth2.Config{
+			ClientID:     data.OAuthClientID.ValueString(),
+			ClientSecret: data.OAuthClientSecret.ValueString(),
+			Endpoint: oauth2.Endpoint{
+				TokenURL:  userTokenURL,
+				AuthStyle: oauth2.AuthStyleInParams,
+			},
+		}
+		// The tokens are refreshed long after Configure returns, so they can't use its context.
+		userTokens := conf.TokenSource(context.Background(), &oauth2.Token{RefreshToken: data.RefreshToken.ValueString()})
+		opt = append(opt, option.WithHTTPClient(&http.Client{
+			Transport: &oauth2.Transport{
+				Source: userTokens,
+				Base:   &insufficientScopeTransport{base: http.DefaultTransport},
+			},
+		}))
+
+		// The tokens of a user have the scopes the user consented to.
+		tokenSource = func(ctx context.Context, scopes []string) oauth2.TokenSource {
+			return userTokens
+		}
 	} else {
 		opt = append(opt, option.WithoutAuthentication())
 
@@ -143,18 +308,45 @@ func (p *GoogleSheetsProvider) Configure(ctx context.Context, req provider.Confi
 
 	resp.DataSourceData = gclient
 	resp.ResourceData = gclient
+	resp.EphemeralResourceData = &EphemeralResourceData{
+		Client:      gclient,
+		TokenSource: tokenSource,
+	}
 }
 
 func (p *GoogleSheetsProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewSheetResource,
 		NewRangeResource,
+		NewProtectedRangeResource,
+		NewDataValidationResource,
+		NewConditionalFormatRuleResource,
+		NewRangeFormatResource,
+		NewDimensionPropertiesResource,
+		NewMergeResource,
+		NewFilterViewResource,
+		NewBandingResource,
+		NewChartResource,
+		NewPivotTableResource,
+		NewDeveloperMetadataResource,
+		NewDimensionGroupResource,
+		NewCellsResource,
+		NewSpreadsheetPropertiesResource,
+		NewFindReplaceResource,
 	}
 }
 
 func (p *GoogleSheetsProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewRangeDataSource,
+		NewSensitiveRangeDataSource,
+	}
+}
+
+func (p *GoogleSheetsProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewAccessTokenEphemeralResource,
+		NewRangeEphemeralResource,
 	}
 }
 
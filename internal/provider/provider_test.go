@@ -1,10 +1,26 @@
 package provider
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"google.golang.org/api/sheets/v4"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -20,3 +36,307 @@ func testAccPreCheck(t *testing.T) {
 	// about the appropriate environment variables being set are common to see in a pre-check
 	// function.
 }
+
+func TestAccProvider_UserCredentials(t *testing.T) {
+	var refreshTokens []string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		err := r.ParseForm()
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if r.PostForm.Get("grant_type") != "refresh_token" || r.PostForm.Get("client_id") != "test-client-id" || r.PostForm.Get("client_secret") != "test-client-secret" {
+			t.Errorf("Unexpected token request %v", r.PostForm)
+		}
+		refreshTokens = append(refreshTokens, r.PostForm.Get("refresh_token"))
+
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "ya29.user-token",
+			"token_type":   "Bearer",
+			"expires_in":   3599,
+		})
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+	mux.HandleFunc("GET /v4/spreadsheets/{spreadsheetId}/values/{range}", func(w http.ResponseWriter, r *http.Request) {
+		if authorization := r.Header.Get("Authorization"); authorization != "Bearer ya29.user-token" {
+			t.Errorf("Unexpected authorization %s", authorization)
+		}
+		err := json.NewEncoder(w).Encode(sheets.ValueRange{Range: r.PathValue("range"), Values: [][]interface{}{{"a"}}})
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	tokenURL := userTokenURL
+	userTokenURL = server.URL + "/token"
+	defer func() {
+		userTokenURL = tokenURL
+	}()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"gsheets": testAccProtoV6ProviderFactories["gsheets"],
+			"echo":    echoprovider.NewProviderServer(),
+		},
+		IsUnitTest: true,
+		Steps: []resource.TestStep{
+			{
+				// The client secret is required to refresh the token.
+				Config: `
+provider "gsheets" {
+	oauth_client_id = "test-client-id"
+	refresh_token = "test-refresh-token"
+}
+
+data "gsheets_range" "test" {
+	spreadsheet_id = "example-sheet-id"
+	range = "A1"
+}
+`,
+				ExpectError: regexp.MustCompile("oauth_client_secret"),
+			},
+			{
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+	oauth_client_id = "test-client-id"
+	oauth_client_secret = "test-client-secret"
+	refresh_token = "test-refresh-token"
+}
+
+data "gsheets_range" "test" {
+	spreadsheet_id = "example-sheet-id"
+	range = "A1"
+}
+
+ephemeral "gsheets_access_token" "test" {}
+
+provider "echo" {
+	data = ephemeral.gsheets_access_token.test.access_token
+}
+
+resource "echo" "test" {}
+`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gsheets_range.test", "values.0.0", "a"),
+					func(s *terraform.State) error {
+						if len(refreshTokens) == 0 || refreshTokens[0] != "test-refresh-token" {
+							return fmt.Errorf("Unexpected refresh tokens %v", refreshTokens)
+						}
+						return nil
+					},
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data"), knownvalue.StringExact("ya29.user-token")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccProvider_AccessToken(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v4/spreadsheets/{spreadsheetId}/values/{range}", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer ya29.static-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		err := json.NewEncoder(w).Encode(sheets.ValueRange{Range: r.PathValue("range"), Values: [][]interface{}{{"a"}}})
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	t.Setenv("GSHEETS_ACCESS_TOKEN", "ya29.static-token")
+
+	config := func(credentials string) string {
+		return fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+	%s
+}
+
+data "gsheets_range" "test" {
+	spreadsheet_id = "example-sheet-id"
+	range = "A1"
+}
+`, server.URL, credentials)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		Steps: []resource.TestStep{
+			{
+				// The configured token takes precedence over the environment variable.
+				Config:      config(`access_token = "ya29.expired-token"`),
+				ExpectError: regexp.MustCompile("access token was rejected, it has probably expired"),
+			},
+			{
+				// The token from the environment variable takes precedence over the service account.
+				Config: config(fmt.Sprintf("service_account_key = %q", testServiceAccountKey(t, server.URL+"/token", nil))),
+				Check:  resource.TestCheckResourceAttr("data.gsheets_range.test", "values.0.0", "a"),
+			},
+		},
+	})
+}
+
+func TestAccProvider_Scopes(t *testing.T) {
+	// The scopes of the tokens minted by the mock, and the scopes used by the requests.
+	tokens := map[string]string{}
+	var readScopes, writeScopes []string
+	values := [][]interface{}{}
+
+	scope := func(r *http.Request) string {
+		return tokens[strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")]
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		err := r.ParseForm()
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		payload, err := base64.RawURLEncoding.DecodeString(strings.Split(r.PostForm.Get("assertion"), ".")[1])
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		claims := struct {
+			Scope string `json:"scope"`
+		}{}
+		err = json.Unmarshal(payload, &claims)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		token := fmt.Sprintf("ya29.token-%d", len(tokens))
+		tokens[token] = claims.Scope
+
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(map[string]interface{}{"access_token": token, "token_type": "Bearer", "expires_in": 3600})
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+	mux.HandleFunc("PUT /v4/spreadsheets/{spreadsheetId}/values/{range}", func(w http.ResponseWriter, r *http.Request) {
+		if scope(r) != ScopeSpreadsheets {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="https://accounts.google.com/", error="insufficient_scope", scope="https://www.googleapis.com/auth/spreadsheets https://www.googleapis.com/auth/drive"`)
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		writeScopes = append(writeScopes, scope(r))
+
+		defer r.Body.Close()
+		requestBody := &sheets.ValueRange{}
+		err := json.NewDecoder(r.Body).Decode(requestBody)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		values = requestBody.Values
+
+		err = json.NewEncoder(w).Encode(sheets.UpdateValuesResponse{SpreadsheetId: r.PathValue("spreadsheetId")})
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+	mux.HandleFunc("GET /v4/spreadsheets/{spreadsheetId}/values/{range}", func(w http.ResponseWriter, r *http.Request) {
+		readScopes = append(readScopes, scope(r))
+		err := json.NewEncoder(w).Encode(sheets.ValueRange{Range: r.PathValue("range"), Values: Clean(values)})
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	key := testServiceAccountKey(t, server.URL+"/token", nil)
+
+	config := func(scopes string) string {
+		return fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+	service_account_key = %q
+	%s
+}
+
+resource "gsheets_range" "test" {
+	spreadsheet_id = "example-sheet-id"
+	range = "A1:B1"
+	values = [["a", "b"]]
+}
+
+data "gsheets_range" "test" {
+	spreadsheet_id = "example-sheet-id"
+	range = gsheets_range.test.range
+}
+`, server.URL, key, scopes)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		Steps: []resource.TestStep{
+			{
+				Config:      config(`scopes = ["https://www.googleapis.com/auth/spreadsheets.readonly"]`),
+				ExpectError: regexp.MustCompile(`missing the scope to write the spreadsheet: add one of\s+https://www.googleapis.com/auth/spreadsheets,\s+https://www.googleapis.com/auth/drive to scopes`),
+			},
+			{
+				// Without scopes, only writes request the spreadsheets scope.
+				Config: config(""),
+				Check: func(s *terraform.State) error {
+					if len(writeScopes) == 0 || len(readScopes) == 0 {
+						return fmt.Errorf("Expected reads and writes, got %v %v", readScopes, writeScopes)
+					}
+					for _, scope := range readScopes {
+						if scope != ScopeSpreadsheetsReadOnly {
+							return fmt.Errorf("Expected reads to request %s, got %v", ScopeSpreadsheetsReadOnly, readScopes)
+						}
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
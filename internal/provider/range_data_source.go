@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -21,28 +23,47 @@ func NewRangeDataSource() datasource.DataSource {
 	return &RangeDataSource{}
 }
 
+// NewSensitiveRangeDataSource returns the data source that reads the same data as gsheets_range, with the values marked as sensitive.
+func NewSensitiveRangeDataSource() datasource.DataSource {
+	return &RangeDataSource{sensitive: true}
+}
+
 // RangeDataSource defines the data source implementation.
 type RangeDataSource struct {
-	client *sheets.Service
+	client    *sheets.Service
+	sensitive bool
 }
 
 // RangeDataSourceModel describes the data source data model.
 type RangeDataSourceModel struct {
-	SpreadsheetID  types.String `tfsdk:"spreadsheet_id"`
-	Range          types.String `tfsdk:"range"`
-	Values         types.List   `tfsdk:"values"`
-	MajorDimension types.String `tfsdk:"major_dimension"`
+	SpreadsheetID  types.String     `tfsdk:"spreadsheet_id"`
+	Range          types.String     `tfsdk:"range"`
+	Values         types.List       `tfsdk:"values"`
+	MajorDimension types.String     `tfsdk:"major_dimension"`
+	DataFilter     *DataFilterModel `tfsdk:"data_filter"`
 }
 
 func (d *RangeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	if d.sensitive {
+		resp.TypeName = req.ProviderTypeName + "_sensitive_range"
+		return
+	}
 	resp.TypeName = req.ProviderTypeName + "_range"
 }
 
 func (d *RangeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: `Allows to fetch data from a spreadsheet by providing the spreadsheet_id and the range.
+	description := `Allows to fetch data from a spreadsheet by providing the spreadsheet_id and the range.
 
-To fetch data from a specific sheet, you must use the range syntax to point to a specific sheet.`,
+To fetch data from a specific sheet, you must use the range syntax to point to a specific sheet.
+The cells can also be located by the developer metadata of their rows or columns with data_filter.`
+	if d.sensitive {
+		description = `Reads data from a spreadsheet like gsheets_range, with the values marked as sensitive so they are hidden from the plan and the output.
+
+The values are still stored in plain text in the state, use the ephemeral gsheets_range to keep them out of it.`
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: description,
 
 		Attributes: map[string]schema.Attribute{
 			"spreadsheet_id": schema.StringAttribute{
@@ -50,8 +71,33 @@ To fetch data from a specific sheet, you must use the range syntax to point to a
 				Required:            true,
 			},
 			"range": schema.StringAttribute{
-				MarkdownDescription: "The range to read. It follows standard range notation documented in google sheets.",
-				Required:            true,
+				MarkdownDescription: "The range to read. It follows standard range notation documented in google sheets. It is computed when data_filter is set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("data_filter")),
+				},
+			},
+			"data_filter": schema.SingleNestedAttribute{
+				MarkdownDescription: "Locates the cells by developer metadata instead of the range. It must match a single range.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"metadata_id": schema.Int64Attribute{
+						MarkdownDescription: "The ID of the developer metadata",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("metadata_key")),
+						},
+					},
+					"metadata_key": schema.StringAttribute{
+						MarkdownDescription: "The key of the developer metadata",
+						Optional:            true,
+					},
+					"metadata_value": schema.StringAttribute{
+						MarkdownDescription: "The value of the developer metadata. It is only used along with the key",
+						Optional:            true,
+					},
+				},
 			},
 			"values": schema.ListAttribute{
 				ElementType: types.ListType{
@@ -59,6 +105,7 @@ To fetch data from a specific sheet, you must use the range syntax to point to a
 				},
 				MarkdownDescription: "The data that will be read",
 				Computed:            true,
+				Sensitive:           d.sensitive,
 			},
 			"major_dimension": schema.StringAttribute{
 				MarkdownDescription: "major dimension for the values",
@@ -100,6 +147,11 @@ func (d *RangeDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	if data.DataFilter != nil {
+		d.readByDataFilter(ctx, &data, resp)
+		return
+	}
+
 	request := d.client.Spreadsheets.Values.Get(data.SpreadsheetID.ValueString(), data.Range.ValueString())
 	if !data.MajorDimension.IsNull() {
 		request.MajorDimension(data.MajorDimension.ValueString())
@@ -127,6 +179,34 @@ func (d *RangeDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// readByDataFilter reads the only range matched by the data filter.
+func (d *RangeDataSource) readByDataFilter(ctx context.Context, data *RangeDataSourceModel, resp *datasource.ReadResponse) {
+	request := d.client.Spreadsheets.Values.BatchGetByDataFilter(data.SpreadsheetID.ValueString(), &sheets.BatchGetValuesByDataFilterRequest{
+		DataFilters:    []*sheets.DataFilter{data.DataFilter.ToDataFilter()},
+		MajorDimension: data.MajorDimension.ValueString(),
+	})
+	request.Context(ctx)
+	response, err := request.Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unexpected error fetching data",
+			err.Error(),
+		)
+		return
+	}
+
+	values, err := MatchedValueRange(response)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to locate the data", err.Error())
+		return
+	}
+
+	data.Range = types.StringValue(values.Range)
+	data.Values = ValuesToList(values.Values)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
 func ValuesToList(values [][]interface{}) basetypes.ListValue {
 	tfAttr := []attr.Value{}
 
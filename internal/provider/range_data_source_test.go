@@ -6,6 +6,7 @@ import (
 	"log"
 	"net/http"
 	"net/http/httptest"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -118,3 +119,136 @@ data "gsheets_range" "test" {
 		},
 	})
 }
+
+func TestAccRangeDataSource_DataFilter(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v4/spreadsheets/{spreadsheetId}/values:batchGetByDataFilter", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		requestBody := &sheets.BatchGetValuesByDataFilterRequest{}
+		err := json.NewDecoder(r.Body).Decode(requestBody)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if id := requestBody.DataFilters[0].DeveloperMetadataLookup.MetadataId; id != 1234 {
+			t.Errorf("Unexpected metadata id %d", id)
+		}
+
+		res := sheets.BatchGetValuesByDataFilterResponse{
+			ValueRanges: []*sheets.MatchedValueRange{
+				{ValueRange: &sheets.ValueRange{
+					Range:  "Sheet1!A8:Z9",
+					Values: [][]interface{}{{"a", "b"}, {"1", "2"}},
+				}},
+			},
+		}
+		err = json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+data "gsheets_range" "test" {
+  spreadsheet_id = "example-sheet-id"
+  data_filter = {
+    metadata_id = 1234
+  }
+}
+`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gsheets_range.test", "range", "Sheet1!A8:Z9"),
+					resource.TestCheckResourceAttr("data.gsheets_range.test", "values.#", "2"),
+					resource.TestCheckResourceAttr("data.gsheets_range.test", "values.1.1", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSensitiveRangeDataSource(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v4/spreadsheets/{spreadsheetId}/values/{range}", func(w http.ResponseWriter, r *http.Request) {
+		res := sheets.ValueRange{
+			Range:  r.PathValue("range"),
+			Values: [][]interface{}{{"stripe", "sk_test_123"}},
+		}
+		err := json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		Steps: []resource.TestStep{
+			{
+				// The values can't be output without marking the output as sensitive.
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+data "gsheets_sensitive_range" "test" {
+  spreadsheet_id = "example-sheet-id"
+  range          = "keys!A1:B1"
+}
+
+output "key" {
+  value = data.gsheets_sensitive_range.test.values[0][1]
+}
+`, server.URL),
+				ExpectError: regexp.MustCompile("Output refers to sensitive values"),
+			},
+			{
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+data "gsheets_sensitive_range" "test" {
+  spreadsheet_id = "example-sheet-id"
+  range          = "keys!A1:B1"
+}
+
+output "key" {
+  value     = data.gsheets_sensitive_range.test.values[0][1]
+  sensitive = true
+}
+`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gsheets_sensitive_range.test", "values.0.1", "sk_test_123"),
+					resource.TestCheckOutput("key", "sk_test_123"),
+				),
+			},
+		},
+	})
+}
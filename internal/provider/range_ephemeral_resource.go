@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &RangeEphemeralResource{}
+
+func NewRangeEphemeralResource() ephemeral.EphemeralResource {
+	return &RangeEphemeralResource{}
+}
+
+type RangeEphemeralResource struct {
+	data *EphemeralResourceData
+}
+
+type RangeEphemeralResourceModel struct {
+	SpreadsheetID  types.String `tfsdk:"spreadsheet_id"`
+	Range          types.String `tfsdk:"range"`
+	MajorDimension types.String `tfsdk:"major_dimension"`
+	Values         types.List   `tfsdk:"values"`
+}
+
+func (r *RangeEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_range"
+}
+
+func (r *RangeEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Reads data from a spreadsheet without storing it in the state or the plan.
+
+Use it for sheets that hold secrets, such as API keys, and pass the values to provider configurations or write-only attributes. It requires Terraform 1.10 or later.`,
+
+		Attributes: map[string]schema.Attribute{
+			"spreadsheet_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID for the spreadsheet. It can be obtained from the URL.",
+				Required:            true,
+			},
+			"range": schema.StringAttribute{
+				MarkdownDescription: "The range to read. It follows standard range notation documented in google sheets.",
+				Required:            true,
+			},
+			"major_dimension": schema.StringAttribute{
+				MarkdownDescription: "major dimension for the values",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("ROWS", "COLUMNS"),
+				},
+			},
+			"values": schema.ListAttribute{
+				ElementType: types.ListType{
+					ElemType: types.StringType,
+				},
+				MarkdownDescription: "The data that will be read",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+// Configure implements ephemeral.EphemeralResourceWithConfigure.
+func (r *RangeEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*EphemeralResourceData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Type",
+			fmt.Sprintf("Expected *EphemeralResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.data = data
+}
+
+// Open is called when the provider must generate a new ephemeral resource. Config
+// values should be read from the OpenRequest and new result values set on the OpenResponse.
+func (r *RangeEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data RangeEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.data == nil {
+		resp.Diagnostics.AddError("Unconfigured provider", "The provider was not configured before reading the range. Please report this issue to the provider developers.")
+		return
+	}
+
+	request := r.data.Client.Spreadsheets.Values.Get(data.SpreadsheetID.ValueString(), data.Range.ValueString())
+	if !data.MajorDimension.IsNull() {
+		request.MajorDimension(data.MajorDimension.ValueString())
+	}
+	request.Context(ctx)
+
+	values, err := request.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read data,", err.Error())
+		return
+	}
+
+	data.Values = ValuesToList(values.Values)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
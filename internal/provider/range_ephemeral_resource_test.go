@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestAccRangeEphemeralResource(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v4/spreadsheets/{spreadsheetId}/values/{range}", func(w http.ResponseWriter, r *http.Request) {
+		if majorDimension := r.URL.Query().Get("majorDimension"); majorDimension != "COLUMNS" {
+			t.Errorf("Expected major dimension to be 'COLUMNS' but it was '%s'", majorDimension)
+		}
+
+		res := sheets.ValueRange{
+			Range:  r.PathValue("range"),
+			Values: [][]interface{}{{"stripe", "github"}, {"sk_test_123", "ghp_456"}},
+		}
+		err := json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"gsheets": testAccProtoV6ProviderFactories["gsheets"],
+			"echo":    echoprovider.NewProviderServer(),
+		},
+		IsUnitTest: true,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+ephemeral "gsheets_range" "test" {
+	spreadsheet_id = "example-sheet-id"
+	range = "keys!A1:B2"
+	major_dimension = "COLUMNS"
+}
+
+provider "echo" {
+	data = ephemeral.gsheets_range.test.values[1][0]
+}
+
+resource "echo" "test" {}
+`, server.URL),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data"), knownvalue.StringExact("sk_test_123")),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,555 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/api/sheets/v4"
+)
+
+var _ resource.ResourceWithConfigure = &RangeFormatResource{}
+
+func NewRangeFormatResource() resource.Resource {
+	return &RangeFormatResource{}
+}
+
+type RangeFormatResource struct {
+	client *sheets.Service
+}
+
+type RangeFormatResourceModel struct {
+	SpreadsheetID       types.String       `tfsdk:"spreadsheet_id"`
+	Range               types.String       `tfsdk:"range"`
+	NumberFormat        *NumberFormatModel `tfsdk:"number_format"`
+	TextFormat          *TextFormatModel   `tfsdk:"text_format"`
+	BackgroundColor     types.String       `tfsdk:"background_color"`
+	HorizontalAlignment types.String       `tfsdk:"horizontal_alignment"`
+	WrapStrategy        types.String       `tfsdk:"wrap_strategy"`
+	Borders             *BordersModel      `tfsdk:"borders"`
+}
+
+type BordersModel struct {
+	Top             *BorderModel `tfsdk:"top"`
+	Bottom          *BorderModel `tfsdk:"bottom"`
+	Left            *BorderModel `tfsdk:"left"`
+	Right           *BorderModel `tfsdk:"right"`
+	InnerHorizontal *BorderModel `tfsdk:"inner_horizontal"`
+	InnerVertical   *BorderModel `tfsdk:"inner_vertical"`
+}
+
+type BorderModel struct {
+	Style types.String `tfsdk:"style"`
+	Color types.String `tfsdk:"color"`
+}
+
+// ToBorder converts the model into the API representation. It returns nil when there is no model.
+func (m *BorderModel) ToBorder() *sheets.Border {
+	if m == nil {
+		return nil
+	}
+	return &sheets.Border{
+		Style: m.Style.ValueString(),
+		Color: ColorFromValue(m.Color),
+	}
+}
+
+// edges returns the borders indexed by their name in the API.
+func (m *BordersModel) edges() map[string]*BorderModel {
+	if m == nil {
+		return map[string]*BorderModel{}
+	}
+	return map[string]*BorderModel{
+		"top":             m.Top,
+		"bottom":          m.Bottom,
+		"left":            m.Left,
+		"right":           m.Right,
+		"innerHorizontal": m.InnerHorizontal,
+		"innerVertical":   m.InnerVertical,
+	}
+}
+
+// cellFormat returns the attributes of the model shared with the format of a single cell.
+func (m RangeFormatResourceModel) cellFormat() *CellFormatModel {
+	return &CellFormatModel{
+		NumberFormat:        m.NumberFormat,
+		TextFormat:          m.TextFormat,
+		BackgroundColor:     m.BackgroundColor,
+		HorizontalAlignment: m.HorizontalAlignment,
+		WrapStrategy:        m.WrapStrategy,
+	}
+}
+
+// ToCellFormat converts the managed attributes into the API representation.
+func (m RangeFormatResourceModel) ToCellFormat() *sheets.CellFormat {
+	return m.cellFormat().ToCellFormat()
+}
+
+// Fields returns the field mask of the cell attributes that are set.
+// Borders are not included because they are updated with a different request.
+func (m RangeFormatResourceModel) Fields() []string {
+	return m.cellFormat().Fields("userEnteredFormat")
+}
+
+// BuildRequests returns the requests that move the format of the range from the previous model to this one.
+// Fields that are no longer managed are reset, so a nil previous model applies the format and
+// applying an empty model over the previous one clears it.
+func (m RangeFormatResourceModel) BuildRequests(gridRange *sheets.GridRange, previous *RangeFormatResourceModel) []*sheets.Request {
+	fields := m.Fields()
+	var previousEdges map[string]*BorderModel
+	if previous != nil {
+		fields = append(fields, previous.Fields()...)
+		previousEdges = previous.Borders.edges()
+	}
+
+	var requests []*sheets.Request
+	if fields = uniqueSorted(fields); len(fields) > 0 {
+		requests = append(requests, &sheets.Request{
+			RepeatCell: &sheets.RepeatCellRequest{
+				Range:  gridRange,
+				Cell:   &sheets.CellData{UserEnteredFormat: m.ToCellFormat()},
+				Fields: strings.Join(fields, ","),
+			},
+		})
+	}
+
+	borders := &sheets.UpdateBordersRequest{Range: gridRange}
+	edges := m.Borders.edges()
+	set := func(name string, target **sheets.Border) {
+		switch {
+		case edges[name] != nil:
+			*target = edges[name].ToBorder()
+		case previousEdges[name] != nil:
+			*target = &sheets.Border{Style: "NONE"}
+		}
+	}
+	set("top", &borders.Top)
+	set("bottom", &borders.Bottom)
+	set("left", &borders.Left)
+	set("right", &borders.Right)
+	set("innerHorizontal", &borders.InnerHorizontal)
+	set("innerVertical", &borders.InnerVertical)
+	if borders.Top != nil || borders.Bottom != nil || borders.Left != nil || borders.Right != nil || borders.InnerHorizontal != nil || borders.InnerVertical != nil {
+		requests = append(requests, &sheets.Request{UpdateBorders: borders})
+	}
+
+	return requests
+}
+
+// Refresh updates the managed attributes with the user entered format of the cells of the range.
+// When the cells don't share the same format, the first value that differs is kept so the drift shows up in the plan.
+func (m *RangeFormatResourceModel) Refresh(sheet *sheets.Sheet, gridRange *sheets.GridRange) {
+	type position struct{ row, column int64 }
+	formats := map[position]*sheets.CellFormat{}
+	var all []*sheets.CellFormat
+	var lastRow, lastColumn int64
+	ForEachCell(sheet, gridRange, func(row, column int64, cell *sheets.CellData) bool {
+		format := &sheets.CellFormat{}
+		if cell != nil && cell.UserEnteredFormat != nil {
+			format = cell.UserEnteredFormat
+		}
+		formats[position{row, column}] = format
+		all = append(all, format)
+		lastRow, lastColumn = row, column
+		return true
+	})
+
+	format := m.cellFormat()
+	format.Refresh(all)
+	m.BackgroundColor = format.BackgroundColor
+	m.HorizontalAlignment = format.HorizontalAlignment
+	m.WrapStrategy = format.WrapStrategy
+
+	if m.Borders == nil {
+		return
+	}
+
+	borders := func(p position) *sheets.Borders {
+		if f, ok := formats[p]; ok && f.Borders != nil {
+			return f.Borders
+		}
+		return &sheets.Borders{}
+	}
+	// Inner borders can be stored on either side of the line, so both cells are checked.
+	edgeAt := map[string]func(p position) (*sheets.Border, bool){
+		"top": func(p position) (*sheets.Border, bool) {
+			return borders(p).Top, p.row == gridRange.StartRowIndex
+		},
+		"bottom": func(p position) (*sheets.Border, bool) {
+			return borders(p).Bottom, p.row == lastRow
+		},
+		"left": func(p position) (*sheets.Border, bool) {
+			return borders(p).Left, p.column == gridRange.StartColumnIndex
+		},
+		"right": func(p position) (*sheets.Border, bool) {
+			return borders(p).Right, p.column == lastColumn
+		},
+		"innerHorizontal": func(p position) (*sheets.Border, bool) {
+			if border := borders(p).Bottom; border != nil {
+				return border, p.row < lastRow
+			}
+			return borders(position{p.row + 1, p.column}).Top, p.row < lastRow
+		},
+		"innerVertical": func(p position) (*sheets.Border, bool) {
+			if border := borders(p).Right; border != nil {
+				return border, p.column < lastColumn
+			}
+			return borders(position{p.row, p.column + 1}).Left, p.column < lastColumn
+		},
+	}
+
+	positions := make([]position, 0, len(formats))
+	for p := range formats {
+		positions = append(positions, p)
+	}
+	sort.Slice(positions, func(i, j int) bool {
+		if positions[i].row != positions[j].row {
+			return positions[i].row < positions[j].row
+		}
+		return positions[i].column < positions[j].column
+	})
+
+	for name, edge := range m.Borders.edges() {
+		if edge == nil {
+			continue
+		}
+		for _, p := range positions {
+			border, ok := edgeAt[name](p)
+			if !ok {
+				continue
+			}
+			if border == nil {
+				border = &sheets.Border{Style: "NONE"}
+			}
+			if border.Style == edge.Style.ValueString() && (border.Style == "NONE" || edge.Color.IsNull() || borderColor(border) == strings.ToUpper(edge.Color.ValueString())) {
+				continue
+			}
+			edge.Style = types.StringValue(border.Style)
+			if !edge.Color.IsNull() {
+				edge.Color = types.StringValue(borderColor(border))
+			}
+			break
+		}
+	}
+}
+
+// borderColor returns the color of the border in #RRGGBB notation, or an empty string if it has none.
+func borderColor(border *sheets.Border) string {
+	if border.Color == nil {
+		return ""
+	}
+	return FormatHexColor(border.Color)
+}
+
+// refreshUniformString returns the current value if every cell shares it, or the first value that differs.
+// Null values are not managed and are never refreshed.
+func refreshUniformString(current types.String, formats []*sheets.CellFormat, get func(*sheets.CellFormat) string) types.String {
+	if current.IsNull() {
+		return current
+	}
+	for _, f := range formats {
+		if value := get(f); value != current.ValueString() {
+			return types.StringValue(value)
+		}
+	}
+	return current
+}
+
+// refreshUniformBool is the same as refreshUniformString for booleans.
+func refreshUniformBool(current types.Bool, formats []*sheets.CellFormat, get func(*sheets.CellFormat) bool) types.Bool {
+	if current.IsNull() {
+		return current
+	}
+	for _, f := range formats {
+		if value := get(f); value != current.ValueBool() {
+			return types.BoolValue(value)
+		}
+	}
+	return current
+}
+
+// refreshUniformColor is the same as refreshUniformString for colors, ignoring differences in case.
+func refreshUniformColor(current types.String, formats []*sheets.CellFormat, get func(*sheets.CellFormat) *sheets.Color) types.String {
+	if current.IsNull() {
+		return current
+	}
+	for _, f := range formats {
+		color := get(f)
+		if color == nil {
+			return types.StringValue("")
+		}
+		if value := RefreshColor(current, color); !value.Equal(current) {
+			return value
+		}
+	}
+	return current
+}
+
+func uniqueSorted(values []string) []string {
+	sort.Strings(values)
+	result := values[:0]
+	for i, value := range values {
+		if i == 0 || value != values[i-1] {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+func (r *RangeFormatResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_range_format"
+}
+
+func (r *RangeFormatResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	border := func(description string) schema.SingleNestedAttribute {
+		return schema.SingleNestedAttribute{
+			MarkdownDescription: description,
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"style": schema.StringAttribute{
+					MarkdownDescription: "The style of the border. One of `DOTTED`, `DASHED`, `SOLID`, `SOLID_MEDIUM`, `SOLID_THICK`, `DOUBLE` or `NONE`",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("DOTTED", "DASHED", "SOLID", "SOLID_MEDIUM", "SOLID_THICK", "DOUBLE", "NONE"),
+					},
+				},
+				"color": schema.StringAttribute{
+					MarkdownDescription: "The color of the border in #RRGGBB notation",
+					Optional:            true,
+					Validators: []validator.String{
+						hexColorValidator(),
+					},
+				},
+			},
+		}
+	}
+
+	// The attributes that format each cell are shared with gsheets_cells.
+	attributes := cellFormatAttributes()
+	for name, attribute := range map[string]schema.Attribute{
+		"spreadsheet_id": schema.StringAttribute{
+			MarkdownDescription: "The unique ID for the spreadsheet. It can be obtained from the URL.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"range": schema.StringAttribute{
+			MarkdownDescription: "The range to format in A1 notation. Use the sheet title to point to a specific sheet.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"borders": schema.SingleNestedAttribute{
+			MarkdownDescription: "The borders of the range",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"top":              border("The border at the top of the range"),
+				"bottom":           border("The border at the bottom of the range"),
+				"left":             border("The border at the left of the range"),
+				"right":            border("The border at the right of the range"),
+				"inner_horizontal": border("The horizontal borders between the rows of the range"),
+				"inner_vertical":   border("The vertical borders between the columns of the range"),
+			},
+		},
+	} {
+		attributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Formats every cell of a range.
+
+Only the attributes that are set are managed, any other format of the cells is left untouched.
+Removing an attribute resets it to the default value. If any cell of the range ends up with a different format, the format is applied again.`,
+
+		Attributes: attributes,
+	}
+}
+
+// Configure implements resource.ResourceWithConfigure.
+func (r *RangeFormatResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sheets.Service)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Type",
+			fmt.Sprintf("Expected *sheets.Service, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create is called when the provider must create a new resource. Config
+// and planned state values should be read from the
+// CreateRequest and new state values set on the CreateResponse.
+func (r *RangeFormatResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RangeFormatResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.format(ctx, data, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to format range", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read is called when the provider must read resource values in order
+// to update state. Planned state values should be read from the
+// ReadRequest and new state values set on the ReadResponse.
+func (r *RangeFormatResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RangeFormatResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The sheet is resolved first, requesting the grid data of a deleted sheet fails.
+	propertiesRequest := r.client.Spreadsheets.Get(data.SpreadsheetID.ValueString())
+	propertiesRequest.Fields("spreadsheetId,sheets.properties")
+	propertiesRequest.Context(ctx)
+	properties, err := propertiesRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read data,", err.Error())
+		return
+	}
+
+	_, err = GridRangeFromA1(properties, data.Range.ValueString())
+	var sheetNotFound *SheetNotFoundError
+	if errors.As(err, &sheetNotFound) {
+		// The format was deleted along with the sheet.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid range", err.Error())
+		return
+	}
+
+	getRequest := r.client.Spreadsheets.Get(data.SpreadsheetID.ValueString())
+	getRequest.Ranges(data.Range.ValueString())
+	getRequest.IncludeGridData(true)
+	getRequest.Fields("spreadsheetId,sheets(properties,data(startRow,startColumn,rowData.values.userEnteredFormat))")
+	getRequest.Context(ctx)
+	spreadsheet, err := getRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read data,", err.Error())
+		return
+	}
+
+	gridRange, err := GridRangeFromA1(spreadsheet, data.Range.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid range", err.Error())
+		return
+	}
+
+	data.Refresh(FindSheetByID(spreadsheet, gridRange.SheetId), gridRange)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is called to update the state of the resource. Config, planned
+// state, and prior state values should be read from the
+// UpdateRequest and new state values set on the UpdateResponse.
+func (r *RangeFormatResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state RangeFormatResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.format(ctx, data, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to perform update request", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete is called when the provider must delete the resource. Config
+// values may be read from the DeleteRequest.
+//
+// If execution completes without error, the framework will automatically
+// call DeleteResponse.State.RemoveResource(), so it can be omitted
+// from provider logic.
+func (r *RangeFormatResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RangeFormatResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Applying an empty format over the state resets every managed attribute.
+	empty := RangeFormatResourceModel{
+		SpreadsheetID:       data.SpreadsheetID,
+		Range:               data.Range,
+		BackgroundColor:     types.StringNull(),
+		HorizontalAlignment: types.StringNull(),
+		WrapStrategy:        types.StringNull(),
+	}
+	err := r.format(ctx, empty, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to clear format", err.Error())
+		return
+	}
+}
+
+func (r *RangeFormatResource) format(ctx context.Context, data RangeFormatResourceModel, previous *RangeFormatResourceModel) error {
+	getRequest := r.client.Spreadsheets.Get(data.SpreadsheetID.ValueString())
+	getRequest.Fields("spreadsheetId,sheets.properties")
+	getRequest.Context(ctx)
+	spreadsheet, err := getRequest.Do()
+	if err != nil {
+		return err
+	}
+
+	gridRange, err := GridRangeFromA1(spreadsheet, data.Range.ValueString())
+	if err != nil {
+		return err
+	}
+
+	requests := data.BuildRequests(gridRange, previous)
+	if len(requests) == 0 {
+		return nil
+	}
+
+	updateRequest := r.client.Spreadsheets.BatchUpdate(data.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: requests,
+	})
+	updateRequest.Context(ctx)
+	_, err = updateRequest.Do()
+	return err
+}
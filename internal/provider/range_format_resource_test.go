@@ -0,0 +1,329 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestAccRangeFormatResource(t *testing.T) {
+	// The mock only knows about the range A1:B2 of the sheet.
+	formats := [2][2]*sheets.CellFormat{}
+	for row := range formats {
+		for column := range formats[row] {
+			formats[row][column] = &sheets.CellFormat{Borders: &sheets.Borders{}}
+		}
+	}
+	var fields []string
+	var updatedBorders *sheets.UpdateBordersRequest
+	sheetDeleted := false
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v4/spreadsheets/{spreadsheetId}", func(w http.ResponseWriter, r *http.Request) {
+		if sheetDeleted {
+			// Ranges of a deleted sheet can't be parsed.
+			if r.URL.Query().Has("ranges") {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			err := json.NewEncoder(w).Encode(sheets.Spreadsheet{SpreadsheetId: r.PathValue("spreadsheetId")})
+			if err != nil {
+				log.Println(err)
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+			return
+		}
+
+		sheet := &sheets.Sheet{
+			Properties: &sheets.SheetProperties{
+				SheetId:        2,
+				Title:          "test title",
+				GridProperties: &sheets.GridProperties{RowCount: 2, ColumnCount: 2},
+			},
+		}
+		if r.URL.Query().Get("includeGridData") == "true" {
+			data := &sheets.GridData{}
+			for _, row := range formats {
+				rowData := &sheets.RowData{}
+				for _, format := range row {
+					rowData.Values = append(rowData.Values, &sheets.CellData{UserEnteredFormat: format})
+				}
+				data.RowData = append(data.RowData, rowData)
+			}
+			sheet.Data = []*sheets.GridData{data}
+		}
+
+		res := sheets.Spreadsheet{
+			SpreadsheetId: r.PathValue("spreadsheetId"),
+			Sheets:        []*sheets.Sheet{sheet},
+		}
+		err := json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+	mux.HandleFunc("POST /v4/spreadsheets/{spreadsheetIdUpdate}", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		requestBody := &sheets.BatchUpdateSpreadsheetRequest{}
+		err := json.NewDecoder(r.Body).Decode(requestBody)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		fields = nil
+		updatedBorders = nil
+		setBorder := func(target **sheets.Border, border *sheets.Border) {
+			switch {
+			case border == nil:
+			case border.Style == "NONE":
+				*target = nil
+			default:
+				*target = border
+			}
+		}
+		for _, request := range requestBody.Requests {
+			switch {
+			case request.RepeatCell != nil:
+				fields = strings.Split(request.RepeatCell.Fields, ",")
+				// The resource always sends every managed field, so the format can be replaced.
+				for row := range formats {
+					for column := range formats[row] {
+						format := *request.RepeatCell.Cell.UserEnteredFormat
+						format.Borders = formats[row][column].Borders
+						formats[row][column] = &format
+					}
+				}
+			case request.UpdateBorders != nil:
+				updatedBorders = request.UpdateBorders
+				for column := range 2 {
+					setBorder(&formats[0][column].Borders.Top, request.UpdateBorders.Top)
+					setBorder(&formats[1][column].Borders.Bottom, request.UpdateBorders.Bottom)
+					setBorder(&formats[0][column].Borders.Bottom, request.UpdateBorders.InnerHorizontal)
+				}
+				for row := range 2 {
+					setBorder(&formats[row][0].Borders.Left, request.UpdateBorders.Left)
+					setBorder(&formats[row][1].Borders.Right, request.UpdateBorders.Right)
+					setBorder(&formats[row][0].Borders.Right, request.UpdateBorders.InnerVertical)
+				}
+			default:
+				t.Errorf("Unexpected request %v", request)
+			}
+		}
+
+		res := sheets.BatchUpdateSpreadsheetResponse{
+			SpreadsheetId: strings.Split(r.PathValue("spreadsheetIdUpdate"), ":")[0],
+			Replies:       make([]*sheets.Response, len(requestBody.Requests)),
+		}
+		err = json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	expectFields := func(expected ...string) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			if strings.Join(fields, ",") != strings.Join(expected, ",") {
+				return fmt.Errorf("Expected fields %v, got %v", expected, fields)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_range_format" "header" {
+	spreadsheet_id = "test-spreadsheet-id"
+	range = "'test title'!A1:B2"
+	number_format = {
+		type = "NUMBER"
+		pattern = "#,##0.00"
+	}
+	text_format = {
+		bold = true
+		font_size = 12
+		foreground_color = "#ffffff"
+	}
+	background_color = "#1A73E8"
+	horizontal_alignment = "CENTER"
+	wrap_strategy = "WRAP"
+	borders = {
+		top = {
+			style = "SOLID"
+		}
+		bottom = {
+			style = "SOLID_THICK"
+			color = "#000000"
+		}
+		inner_horizontal = {
+			style = "DOTTED"
+		}
+	}
+}
+	`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_range_format.header", "number_format.type", "NUMBER"),
+					resource.TestCheckResourceAttr("gsheets_range_format.header", "text_format.bold", "true"),
+					resource.TestCheckResourceAttr("gsheets_range_format.header", "text_format.foreground_color", "#ffffff"),
+					resource.TestCheckNoResourceAttr("gsheets_range_format.header", "text_format.italic"),
+					resource.TestCheckResourceAttr("gsheets_range_format.header", "background_color", "#1A73E8"),
+					resource.TestCheckResourceAttr("gsheets_range_format.header", "borders.bottom.style", "SOLID_THICK"),
+					expectFields(
+						"userEnteredFormat.backgroundColor",
+						"userEnteredFormat.horizontalAlignment",
+						"userEnteredFormat.numberFormat",
+						"userEnteredFormat.textFormat.bold",
+						"userEnteredFormat.textFormat.fontSize",
+						"userEnteredFormat.textFormat.foregroundColor",
+						"userEnteredFormat.wrapStrategy",
+					),
+				),
+			},
+			{
+				// Someone removed the bold from one cell, the format must be applied again.
+				PreConfig: func() {
+					format := *formats[1][1]
+					format.TextFormat = &sheets.TextFormat{FontSize: 12, ForegroundColor: format.TextFormat.ForegroundColor}
+					formats[1][1] = &format
+					fields = nil
+				},
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_range_format" "header" {
+	spreadsheet_id = "test-spreadsheet-id"
+	range = "'test title'!A1:B2"
+	number_format = {
+		type = "NUMBER"
+		pattern = "#,##0.00"
+	}
+	text_format = {
+		bold = true
+		font_size = 12
+		foreground_color = "#ffffff"
+	}
+	background_color = "#1A73E8"
+	horizontal_alignment = "CENTER"
+	wrap_strategy = "WRAP"
+	borders = {
+		top = {
+			style = "SOLID"
+		}
+		bottom = {
+			style = "SOLID_THICK"
+			color = "#000000"
+		}
+		inner_horizontal = {
+			style = "DOTTED"
+		}
+	}
+}
+	`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_range_format.header", "text_format.bold", "true"),
+					func(s *terraform.State) error {
+						if !formats[1][1].TextFormat.Bold {
+							return fmt.Errorf("Expected the format to be applied again")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// Attributes removed from the configuration are reset.
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_range_format" "header" {
+	spreadsheet_id = "test-spreadsheet-id"
+	range = "'test title'!A1:B2"
+	text_format = {
+		bold = true
+	}
+	horizontal_alignment = "CENTER"
+	borders = {
+		top = {
+			style = "SOLID"
+		}
+	}
+}
+	`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("gsheets_range_format.header", "background_color"),
+					resource.TestCheckNoResourceAttr("gsheets_range_format.header", "borders.bottom"),
+					expectFields(
+						"userEnteredFormat.backgroundColor",
+						"userEnteredFormat.horizontalAlignment",
+						"userEnteredFormat.numberFormat",
+						"userEnteredFormat.textFormat.bold",
+						"userEnteredFormat.textFormat.fontSize",
+						"userEnteredFormat.textFormat.foregroundColor",
+						"userEnteredFormat.wrapStrategy",
+					),
+					func(s *terraform.State) error {
+						if updatedBorders.Bottom == nil || updatedBorders.Bottom.Style != "NONE" {
+							return fmt.Errorf("Expected the bottom border to be removed, got %v", updatedBorders.Bottom)
+						}
+						if formats[1][0].Borders.Bottom != nil || formats[0][0].Borders.Top == nil {
+							return fmt.Errorf("Unexpected borders %v", formats[0][0].Borders)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// The sheet was deleted by hand, along with its format.
+				PreConfig: func() {
+					sheetDeleted = true
+					formats[0][0] = &sheets.CellFormat{Borders: &sheets.Borders{}}
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+				Check: func(s *terraform.State) error {
+					if len(s.RootModule().Resources) != 0 {
+						return fmt.Errorf("Expected the format to be removed from the state, got %v", s.RootModule().Resources)
+					}
+					return nil
+				},
+			},
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			format := formats[0][0]
+			if format.TextFormat != nil || format.HorizontalAlignment != "" || format.Borders.Top != nil {
+				return fmt.Errorf("Expected the format to be cleared, got %v", format)
+			}
+			return nil
+		},
+	})
+}
@@ -2,13 +2,19 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -20,6 +26,7 @@ import (
 )
 
 var _ resource.ResourceWithConfigure = &RangeResource{}
+var _ resource.ResourceWithModifyPlan = &RangeResource{}
 var _ resource.ResourceWithImportState = &RangeResource{}
 
 func NewRangeResource() resource.Resource {
@@ -31,16 +38,48 @@ type RangeResource struct {
 }
 
 type RangeResourceModel struct {
-	SpreadsheetID    types.String `tfsdk:"spreadsheet_id"`
-	Range            types.String `tfsdk:"range"`
-	ValueInputOption types.String `tfsdk:"value_input_option"`
-	Values           types.List   `tfsdk:"values"`
-	MajorDimension   types.String `tfsdk:"major_dimension"`
+	SpreadsheetID    types.String       `tfsdk:"spreadsheet_id"`
+	Range            types.String       `tfsdk:"range"`
+	ValueInputOption types.String       `tfsdk:"value_input_option"`
+	Values           types.List         `tfsdk:"values"`
+	MajorDimension   types.String       `tfsdk:"major_dimension"`
+	Protect          *RangeProtectModel `tfsdk:"protect"`
+	DataFilter       *DataFilterModel   `tfsdk:"data_filter"`
+	InsertMode       types.String       `tfsdk:"insert_mode"`
+	Notes            types.List         `tfsdk:"notes"`
+	Hyperlinks       types.List         `tfsdk:"hyperlinks"`
+	SortSpecs        []SortSpecModel    `tfsdk:"sort_specs"`
+}
+
+type RangeProtectModel struct {
+	ProtectedRangeID types.Int64                 `tfsdk:"protected_range_id"`
+	Range            types.String                `tfsdk:"range"`
+	Description      types.String                `tfsdk:"description"`
+	WarningOnly      types.Bool                  `tfsdk:"warning_only"`
+	Editors          *ProtectedRangeEditorsModel `tfsdk:"editors"`
+}
+
+// ToProtectedRangeModel returns the protection of the cells as a protected range.
+func (m RangeResourceModel) ToProtectedRangeModel() ProtectedRangeResourceModel {
+	return ProtectedRangeResourceModel{
+		SpreadsheetID:    m.SpreadsheetID,
+		ProtectedRangeID: m.Protect.ProtectedRangeID,
+		Range:            m.Protect.Range,
+		NamedRangeID:     types.StringNull(),
+		Description:      m.Protect.Description,
+		WarningOnly:      m.Protect.WarningOnly,
+		Editors:          m.Protect.Editors,
+	}
 }
 
 func (m RangeResourceModel) ToInterface() [][]interface{} {
+	return ListToInterface(m.Values)
+}
+
+// ListToInterface converts a matrix of strings, like values or notes, into the format used by the API.
+func ListToInterface(list types.List) [][]interface{} {
 	values := [][]interface{}{}
-	for _, el := range m.Values.Elements() {
+	for _, el := range list.Elements() {
 		row := []interface{}{}
 		elListValue, _ := el.(basetypes.ListValue)
 		for _, ell := range elListValue.Elements() {
@@ -139,6 +178,242 @@ func KeepDimensions(reference [][]interface{}, data [][]interface{}) [][]interfa
 	return Merge(result, data)
 }
 
+// Shifts reports whether rows or columns are inserted or deleted when the number of values changes.
+func (m RangeResourceModel) Shifts() bool {
+	return m.InsertMode.ValueString() == "shift"
+}
+
+// BuildShiftRequest returns the request that inserts or deletes the rows, or the columns, after the first ones of the range
+// so the current number of values fits without overwriting the cells after them. It returns nil when the number doesn't change.
+func BuildShiftRequest(gridRange *sheets.GridRange, majorDimension string, previous, current int64) *sheets.Request {
+	dimension, start := "ROWS", gridRange.StartRowIndex
+	if majorDimension == "COLUMNS" {
+		dimension, start = "COLUMNS", gridRange.StartColumnIndex
+	}
+
+	switch {
+	case current > previous:
+		return &sheets.Request{
+			InsertDimension: &sheets.InsertDimensionRequest{
+				Range: &sheets.DimensionRange{
+					SheetId:         gridRange.SheetId,
+					Dimension:       dimension,
+					StartIndex:      start + previous,
+					EndIndex:        start + current,
+					ForceSendFields: []string{"SheetId", "StartIndex"},
+				},
+				// The new cells take the format of the values above them, like when a human inserts rows.
+				InheritFromBefore: start+previous > 0,
+			},
+		}
+	case current < previous:
+		return &sheets.Request{
+			DeleteDimension: &sheets.DeleteDimensionRequest{
+				Range: &sheets.DimensionRange{
+					SheetId:         gridRange.SheetId,
+					Dimension:       dimension,
+					StartIndex:      start + current,
+					EndIndex:        start + previous,
+					ForceSendFields: []string{"SheetId", "StartIndex"},
+				},
+			},
+		}
+	}
+	return nil
+}
+
+// ManagesCells reports whether notes or hyperlinks are managed along with the values.
+func (m RangeResourceModel) ManagesCells() bool {
+	return !m.Notes.IsNull() || !m.Hyperlinks.IsNull()
+}
+
+// Transpose swaps rows and columns. Short rows are filled with empty strings.
+func Transpose(values [][]interface{}) [][]interface{} {
+	result := [][]interface{}{}
+	for i, row := range values {
+		for j, value := range row {
+			for len(result) <= j {
+				result = append(result, []interface{}{})
+			}
+			for len(result[j]) < i {
+				result[j] = append(result[j], "")
+			}
+			result[j] = append(result[j], value)
+		}
+	}
+	return result
+}
+
+// BuildCellsRequest returns the request that writes the notes and hyperlinks of the cells from the start of the grid range.
+// The matrices follow the major dimension of the values. A nil matrix is left untouched.
+func BuildCellsRequest(gridRange *sheets.GridRange, majorDimension string, notes, hyperlinks [][]interface{}) *sheets.Request {
+	if majorDimension == "COLUMNS" {
+		notes, hyperlinks = Transpose(notes), Transpose(hyperlinks)
+	}
+
+	rows := []*sheets.RowData{}
+	cellAt := func(i, j int) *sheets.CellData {
+		for len(rows) <= i {
+			rows = append(rows, &sheets.RowData{})
+		}
+		for len(rows[i].Values) <= j {
+			rows[i].Values = append(rows[i].Values, &sheets.CellData{})
+		}
+		return rows[i].Values[j]
+	}
+
+	fields := []string{}
+	if notes != nil {
+		fields = append(fields, "note")
+		for i, row := range notes {
+			for j, note := range row {
+				cellAt(i, j).Note, _ = note.(string)
+			}
+		}
+	}
+	if hyperlinks != nil {
+		fields = append(fields, "textFormatRuns")
+		for i, row := range hyperlinks {
+			for j, hyperlink := range row {
+				cell := cellAt(i, j)
+				// An empty link removes the runs, so the cell is written as plain text.
+				if uri, _ := hyperlink.(string); uri != "" {
+					cell.TextFormatRuns = []*sheets.TextFormatRun{
+						{Format: &sheets.TextFormat{Link: &sheets.Link{Uri: uri}}},
+					}
+				}
+			}
+		}
+	}
+
+	return &sheets.Request{
+		UpdateCells: &sheets.UpdateCellsRequest{
+			Start: &sheets.GridCoordinate{
+				SheetId:         gridRange.SheetId,
+				RowIndex:        gridRange.StartRowIndex,
+				ColumnIndex:     gridRange.StartColumnIndex,
+				ForceSendFields: []string{"SheetId", "RowIndex", "ColumnIndex"},
+			},
+			Rows:   rows,
+			Fields: strings.Join(fields, ","),
+		},
+	}
+}
+
+// NotesAndHyperlinks returns the notes and hyperlinks found in the grid data of the sheet from the start of the grid range,
+// following the major dimension of the values. The link of a cell is the one of its first text format run with a link.
+func NotesAndHyperlinks(sheet *sheets.Sheet, gridRange *sheets.GridRange, majorDimension string) (notes, hyperlinks [][]interface{}) {
+	notes, hyperlinks = [][]interface{}{}, [][]interface{}{}
+	for _, data := range sheet.Data {
+		for i, rowData := range data.RowData {
+			notesRow, hyperlinksRow := []interface{}{}, []interface{}{}
+			for _, cell := range rowData.Values {
+				hyperlink := ""
+				for _, run := range cell.TextFormatRuns {
+					if run.Format != nil && run.Format.Link != nil && run.Format.Link.Uri != "" {
+						hyperlink = run.Format.Link.Uri
+						break
+					}
+				}
+				notesRow = append(notesRow, cell.Note)
+				hyperlinksRow = append(hyperlinksRow, hyperlink)
+			}
+
+			row := int(data.StartRow - gridRange.StartRowIndex + int64(i))
+			column := int(data.StartColumn - gridRange.StartColumnIndex)
+			if row < 0 || column < 0 {
+				continue
+			}
+			for len(notes) <= row {
+				notes = append(notes, []interface{}{})
+				hyperlinks = append(hyperlinks, []interface{}{})
+			}
+			offset := make([]interface{}, column)
+			for j := range offset {
+				offset[j] = ""
+			}
+			notes[row] = append(offset, notesRow...)
+			hyperlinks[row] = append(append([]interface{}{}, offset...), hyperlinksRow...)
+		}
+	}
+
+	if majorDimension == "COLUMNS" {
+		notes, hyperlinks = Transpose(notes), Transpose(hyperlinks)
+	}
+	return Clean(notes), Clean(hyperlinks)
+}
+
+// WrittenGridRange returns the rectangle written with the values from the start of the grid range.
+func WrittenGridRange(gridRange *sheets.GridRange, majorDimension string, values [][]interface{}) *sheets.GridRange {
+	if majorDimension == "COLUMNS" {
+		values = Transpose(values)
+	}
+	columns := 0
+	for _, row := range values {
+		columns = max(columns, len(row))
+	}
+
+	return &sheets.GridRange{
+		SheetId:          gridRange.SheetId,
+		StartRowIndex:    gridRange.StartRowIndex,
+		EndRowIndex:      gridRange.StartRowIndex + int64(len(values)),
+		StartColumnIndex: gridRange.StartColumnIndex,
+		EndColumnIndex:   gridRange.StartColumnIndex + int64(columns),
+		// A range at A1 of the first sheet has every index at 0, omitting them would mean the whole sheet.
+		ForceSendFields: []string{"SheetId", "StartRowIndex", "EndRowIndex", "StartColumnIndex", "EndColumnIndex"},
+	}
+}
+
+// ProtectedGridRange returns the cells covered by the protection of the range: the ones written with the values.
+// Without values, nothing is written and the range is protected as it is.
+func (m RangeResourceModel) ProtectedGridRange(spreadsheet *sheets.Spreadsheet) (*sheets.GridRange, error) {
+	gridRange, err := GridRangeFromA1(spreadsheet, m.Range.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	values := m.ToInterface()
+	if len(values) == 0 {
+		return gridRange, nil
+	}
+	return WrittenGridRange(gridRange, m.MajorDimension.ValueString(), values), nil
+}
+
+// BuildSortRequest returns the request that sorts the rows of the rectangle written with the values from the start of the grid range.
+func BuildSortRequest(gridRange *sheets.GridRange, majorDimension string, values [][]interface{}, specs []SortSpecModel) *sheets.Request {
+	return &sheets.Request{
+		SortRange: &sheets.SortRangeRequest{
+			Range:     WrittenGridRange(gridRange, majorDimension, values),
+			SortSpecs: ToSortSpecs(specs),
+		},
+	}
+}
+
+// SameRows reports whether both values have the same rows, no matter their order.
+// Empty strings at the end of the rows are ignored, like google sheets does when reading them.
+func SameRows(a, b [][]interface{}) bool {
+	count := func(values [][]interface{}) map[string]int {
+		rows := map[string]int{}
+		for _, row := range values {
+			key, _ := json.Marshal(removeTrailingEmptyStrings(append([]interface{}{}, row...)))
+			rows[string(key)]++
+		}
+		return rows
+	}
+	return reflect.DeepEqual(count(a), count(b))
+}
+
+// cellsToWrite returns the notes or hyperlinks to write so the previous ones outside of the planned matrix are cleared.
+// It returns nil when they are not managed.
+func cellsToWrite(previous, planned types.List) [][]interface{} {
+	switch {
+	case !planned.IsNull():
+		return KeepDimensions(ListToInterface(previous), ListToInterface(planned))
+	case !previous.IsNull():
+		return Clear(ListToInterface(previous))
+	}
+	return nil
+}
+
 func (m RangeResourceModel) KeepDimensions(reference [][]interface{}) [][]interface{} {
 	newValues := m.ToInterface()
 	return KeepDimensions(reference, newValues)
@@ -161,12 +436,15 @@ func (r *RangeResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"range": schema.StringAttribute{
-				MarkdownDescription: "The range to read",
-				Required:            true,
+				MarkdownDescription: "The range to read. It is computed when the cells are located with `data_filter`.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"data_filter": dataFilterAttribute("Locates the cells by the developer metadata of their rows or columns instead of the range, so they are found even after rows are inserted above them. It must match a single range."),
 			"value_input_option": schema.StringAttribute{
 				MarkdownDescription: "how to post data",
 				Computed:            true,
@@ -197,6 +475,67 @@ func (r *RangeResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"insert_mode": schema.StringAttribute{
+				MarkdownDescription: "What happens to the cells after the values when the number of rows, or columns for `COLUMNS` major dimension, changes. " +
+					"`overwrite` writes the values over them. `shift` inserts or deletes rows so they move instead, and only the rows with values are managed. " +
+					"With `shift`, creating the resource inserts the rows for the values, so the cells that were at the start of the range move after them, and destroying it deletes those rows. Defaults to `overwrite`",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("overwrite"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("overwrite", "shift"),
+				},
+			},
+			"notes": schema.ListAttribute{
+				ElementType: types.ListType{
+					ElemType: types.StringType,
+				},
+				MarkdownDescription: "The notes of the cells, with the same shape as `values`. Empty strings remove the note. Notes are not managed if it is not set. It can't be combined with `sort_specs`, which moves them along with their rows.",
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.ConflictsWith(path.MatchRoot("sort_specs")),
+				},
+			},
+			"hyperlinks": schema.ListAttribute{
+				ElementType: types.ListType{
+					ElemType: types.StringType,
+				},
+				MarkdownDescription: "The links of the cells, with the same shape as `values`. The whole text of the cell links to the URL. Empty strings remove the link. Links are not managed if it is not set. It can't be combined with `sort_specs`, which moves them along with their rows.",
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.ConflictsWith(path.MatchRoot("sort_specs")),
+				},
+			},
+			"sort_specs": sortSpecsAttribute("Sorts the written rows after every write. Rows that only changed their position are not a difference. " +
+				"Only as many rows as `values` are sorted and compared, so rows appended after them are left alone."),
+			"protect": schema.SingleNestedAttribute{
+				MarkdownDescription: "Protects the written cells so only the given editors can modify them. The protection is removed when the resource is destroyed.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"protected_range_id": schema.Int64Attribute{
+						MarkdownDescription: "The ID of the protected range assigned by google sheets",
+						Computed:            true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+					"range": schema.StringAttribute{
+						MarkdownDescription: "The cells covered by the protection in A1 notation: the ones written with `values` from the start of `range`, or `range` itself when there are no values. It follows the size of the values.",
+						Computed:            true,
+					},
+					"description": schema.StringAttribute{
+						MarkdownDescription: "The description of the protected range",
+						Optional:            true,
+					},
+					"warning_only": schema.BoolAttribute{
+						MarkdownDescription: "Allows everyone to edit the range, showing a warning before doing it. It can't be combined with `editors`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"editors": protectedRangeEditorsAttribute(),
+				},
+			},
 		},
 	}
 }
@@ -235,13 +574,55 @@ func (r *RangeResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	updateRequest := r.buildUpdateCall(ctx, &data)
-	_, err := updateRequest.Do()
+	// Cells located with a data filter already exist, there is nothing to make room for.
+	if data.Shifts() && data.DataFilter == nil {
+		err := r.shift(ctx, &data, 0)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to shift cells", err.Error())
+			return
+		}
+	}
+
+	err := r.write(ctx, &data)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to perform update", err.Error())
 		return
 	}
 
+	if data.DataFilter != nil {
+		valueRange, err := r.read(ctx, &data)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to read data,", err.Error())
+			return
+		}
+		data.Range = types.StringValue(valueRange.Range)
+	}
+
+	if data.ManagesCells() {
+		err = r.writeCells(ctx, &data, ListToInterface(data.Notes), ListToInterface(data.Hyperlinks))
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to write notes and hyperlinks", err.Error())
+			return
+		}
+	}
+
+	if data.SortSpecs != nil {
+		err = r.sort(ctx, &data, data.ToInterface())
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to sort range", err.Error())
+			return
+		}
+	}
+
+	if data.Protect != nil {
+		protectedRangeID, err := r.protect(ctx, &data, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to protect range", err.Error())
+			return
+		}
+		data.Protect.ProtectedRangeID = basetypes.NewInt64Value(protectedRangeID)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -272,6 +653,9 @@ func (r *RangeResource) ImportState(ctx context.Context, req resource.ImportStat
 
 	data.Values = ValuesToList(getResponse.Values)
 	data.ValueInputOption = basetypes.NewStringValue("USER_ENTERED")
+	data.InsertMode = basetypes.NewStringValue("overwrite")
+	data.Notes = types.ListNull(types.ListType{ElemType: types.StringType})
+	data.Hyperlinks = types.ListNull(types.ListType{ElemType: types.StringType})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
@@ -288,25 +672,117 @@ func (r *RangeResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	getRequest := r.client.Spreadsheets.Values.Get(data.SpreadsheetID.ValueString(), data.Range.ValueString())
-	if !data.MajorDimension.IsNull() {
-		getRequest.MajorDimension(data.MajorDimension.ValueString())
-	}
-
-	getRequest.Context(ctx)
-	getResponse, err := getRequest.Do()
+	getResponse, err := r.read(ctx, &data)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to read data,", err.Error())
 		return
 	}
+	if data.DataFilter != nil {
+		data.Range = types.StringValue(getResponse.Range)
+	}
 
 	rowValues := data.ToInterface()
-	extended := KeepDimensions(rowValues, getResponse.Values)
-	data.Values = ValuesToList(extended)
+	readValues := getResponse.Values
+	// The cells after the managed ones belong to someone else. Sorting only moves the managed rows, so the ones appended after them are not managed either.
+	if (data.Shifts() || data.SortSpecs != nil) && len(readValues) > len(rowValues) {
+		readValues = readValues[:len(rowValues)]
+	}
+	// The rows are sorted after every write, so their order is not a difference.
+	if data.SortSpecs == nil || !SameRows(rowValues, readValues) {
+		extended := KeepDimensions(rowValues, readValues)
+		data.Values = ValuesToList(extended)
+	}
+
+	if data.ManagesCells() {
+		err = r.readCells(ctx, &data)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to read notes and hyperlinks", err.Error())
+			return
+		}
+	}
+
+	if data.Protect != nil {
+		err = r.readProtection(ctx, &data)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to read protected range", err.Error())
+			return
+		}
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// The protection covers the written cells, so it is planned from the values. A protection changed outside of terraform is restored.
+func (r *RangeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var protect types.Object
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("protect"), &protect)...)
+	if resp.Diagnostics.HasError() || protect.IsNull() || protect.IsUnknown() {
+		return
+	}
+
+	var data RangeResourceModel
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("spreadsheet_id"), &data.SpreadsheetID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("range"), &data.Range)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("major_dimension"), &data.MajorDimension)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("values"), &data.Values)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	protectedRange := path.Root("protect").AtName("range")
+	planned, err := r.plannedProtection(ctx, req, &data)
+	if err != nil || planned.IsUnknown() {
+		// The sheet may be created in the same apply, the range is resolved then.
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, protectedRange, types.StringUnknown())...)
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, protectedRange, planned)...)
+}
+
+// plannedProtection returns the cells the protection will cover, or an unknown value if they can't be known before apply.
+// The current protection is kept as it is written when it already covers them.
+func (r *RangeResource) plannedProtection(ctx context.Context, req resource.ModifyPlanRequest, data *RangeResourceModel) (types.String, error) {
+	if data.SpreadsheetID.IsUnknown() || data.Range.IsUnknown() || data.MajorDimension.IsUnknown() || data.Values.IsUnknown() {
+		return types.StringUnknown(), nil
+	}
+	for _, row := range data.Values.Elements() {
+		if row.IsUnknown() {
+			return types.StringUnknown(), nil
+		}
+	}
+
+	current := types.StringNull()
+	if !req.State.Raw.IsNull() {
+		var protect types.Object
+		diags := req.State.GetAttribute(ctx, path.Root("protect"), &protect)
+		if !diags.HasError() && !protect.IsNull() {
+			diags = req.State.GetAttribute(ctx, path.Root("protect").AtName("range"), &current)
+		}
+		if diags.HasError() {
+			return types.StringUnknown(), nil
+		}
+	}
+
+	getRequest := r.client.Spreadsheets.Get(data.SpreadsheetID.ValueString())
+	getRequest.Fields("spreadsheetId,sheets.properties")
+	getRequest.Context(ctx)
+	spreadsheet, err := getRequest.Do()
+	if err != nil {
+		return types.StringUnknown(), err
+	}
+
+	gridRange, err := data.ProtectedGridRange(spreadsheet)
+	if err != nil {
+		return types.StringUnknown(), err
+	}
+	return RefreshA1(spreadsheet, current, gridRange)
+}
+
 // Update is called to update the state of the resource. Config, planned
 // state, and prior state values should be read from the
 // UpdateRequest and new state values set on the UpdateResponse.
@@ -325,15 +801,66 @@ func (r *RangeResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	newState.Values = planData.Values
 	newState.ValueInputOption = planData.ValueInputOption
+	newState.Protect = planData.Protect
+	newState.InsertMode = planData.InsertMode
+	newState.Notes = planData.Notes
+	newState.Hyperlinks = planData.Hyperlinks
+	newState.SortSpecs = planData.SortSpecs
 
-	planData.Values = ValuesToList(KeepDimensions(originalState.ToInterface(), planData.ToInterface()))
-	updateRequest := r.buildUpdateCall(ctx, &planData)
-	_, err := updateRequest.Do()
+	reference := originalState.ToInterface()
+	if planData.Shifts() {
+		err := r.shift(ctx, &planData, int64(len(reference)))
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to shift cells", err.Error())
+			return
+		}
+		// The deleted rows are gone, the ones after them must not be cleared.
+		if values := planData.ToInterface(); len(reference) > len(values) {
+			reference = reference[:len(values)]
+		}
+	}
+
+	planData.Values = ValuesToList(KeepDimensions(reference, planData.ToInterface()))
+	err := r.write(ctx, &planData)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to perform update request", err.Error())
 		return
 	}
 
+	if originalState.ManagesCells() || planData.ManagesCells() {
+		err = r.writeCells(ctx, &planData, cellsToWrite(originalState.Notes, planData.Notes), cellsToWrite(originalState.Hyperlinks, planData.Hyperlinks))
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to write notes and hyperlinks", err.Error())
+			return
+		}
+	}
+
+	if planData.SortSpecs != nil {
+		// The values of the plan were padded to clear the previous cells, only the written ones are sorted.
+		err = r.sort(ctx, &planData, newState.ToInterface())
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to sort range", err.Error())
+			return
+		}
+	}
+
+	switch {
+	case originalState.Protect != nil && planData.Protect == nil:
+		err = r.unprotect(ctx, &originalState)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to remove range protection", err.Error())
+			return
+		}
+	case planData.Protect != nil:
+		// The values of the plan were padded to clear the previous cells, the new state has the ones that are written.
+		protectedRangeID, err := r.protect(ctx, &newState, originalState.Protect)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to protect range", err.Error())
+			return
+		}
+		newState.Protect.ProtectedRangeID = basetypes.NewInt64Value(protectedRangeID)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
 }
 
@@ -352,16 +879,87 @@ func (r *RangeResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	if data.Protect != nil {
+		err := r.unprotect(ctx, &data)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to remove range protection", err.Error())
+			return
+		}
+	}
+
+	// The rows inserted for the values are deleted, along with their notes and hyperlinks, so the cells after them move back.
+	// Cells located with a data filter were not inserted by the resource, they are only cleared.
+	if data.Shifts() && data.DataFilter == nil {
+		previous := int64(len(data.ToInterface()))
+		data.Values = ValuesToList(nil)
+		err := r.shift(ctx, &data, previous)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to shift cells", err.Error())
+		}
+		return
+	}
+
 	data.Values = ValuesToList(Clear(data.ToInterface()))
 
-	clearRequest := r.buildUpdateCall(ctx, &data)
-	clearRequest.Context(ctx)
-	_, err := clearRequest.Do()
+	err := r.write(ctx, &data)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to update data,", err.Error())
 		return
 	}
 
+	if data.ManagesCells() {
+		unmanaged := types.ListNull(types.ListType{ElemType: types.StringType})
+		err = r.writeCells(ctx, &data, cellsToWrite(data.Notes, unmanaged), cellsToWrite(data.Hyperlinks, unmanaged))
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to remove notes and hyperlinks", err.Error())
+			return
+		}
+	}
+}
+
+// write sets the values of the cells, locating them by the data filter when it is set.
+func (r *RangeResource) write(ctx context.Context, data *RangeResourceModel) error {
+	if data.DataFilter == nil {
+		_, err := r.buildUpdateCall(ctx, data).Do()
+		return err
+	}
+
+	updateRequest := r.client.Spreadsheets.Values.BatchUpdateByDataFilter(data.SpreadsheetID.ValueString(), &sheets.BatchUpdateValuesByDataFilterRequest{
+		Data: []*sheets.DataFilterValueRange{
+			{
+				DataFilter:     data.DataFilter.ToDataFilter(),
+				MajorDimension: data.MajorDimension.ValueString(),
+				Values:         data.ToInterface(),
+			},
+		},
+		ValueInputOption: data.ValueInputOption.ValueString(),
+	})
+	updateRequest.Context(ctx)
+	_, err := updateRequest.Do()
+	return err
+}
+
+// read gets the values of the cells, locating them by the data filter when it is set.
+func (r *RangeResource) read(ctx context.Context, data *RangeResourceModel) (*sheets.ValueRange, error) {
+	if data.DataFilter == nil {
+		getRequest := r.client.Spreadsheets.Values.Get(data.SpreadsheetID.ValueString(), data.Range.ValueString())
+		if !data.MajorDimension.IsNull() {
+			getRequest.MajorDimension(data.MajorDimension.ValueString())
+		}
+		getRequest.Context(ctx)
+		return getRequest.Do()
+	}
+
+	getRequest := r.client.Spreadsheets.Values.BatchGetByDataFilter(data.SpreadsheetID.ValueString(), &sheets.BatchGetValuesByDataFilterRequest{
+		DataFilters:    []*sheets.DataFilter{data.DataFilter.ToDataFilter()},
+		MajorDimension: data.MajorDimension.ValueString(),
+	})
+	getRequest.Context(ctx)
+	getResponse, err := getRequest.Do()
+	if err != nil {
+		return nil, err
+	}
+	return MatchedValueRange(getResponse)
 }
 
 func (r *RangeResource) buildUpdateCall(ctx context.Context, data *RangeResourceModel) *sheets.SpreadsheetsValuesUpdateCall {
@@ -378,3 +976,225 @@ func (r *RangeResource) buildUpdateCall(ctx context.Context, data *RangeResource
 	updateRequest.ValueInputOption(data.ValueInputOption.ValueString())
 	return updateRequest
 }
+
+// writeCells writes the notes and hyperlinks of the cells. Nil matrices are left untouched.
+func (r *RangeResource) writeCells(ctx context.Context, data *RangeResourceModel, notes, hyperlinks [][]interface{}) error {
+	getRequest := r.client.Spreadsheets.Get(data.SpreadsheetID.ValueString())
+	getRequest.Fields("spreadsheetId,sheets.properties")
+	getRequest.Context(ctx)
+	spreadsheet, err := getRequest.Do()
+	if err != nil {
+		return err
+	}
+
+	gridRange, err := GridRangeFromA1(spreadsheet, data.Range.ValueString())
+	if err != nil {
+		return err
+	}
+
+	batchRequest := r.client.Spreadsheets.BatchUpdate(data.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{BuildCellsRequest(gridRange, data.MajorDimension.ValueString(), notes, hyperlinks)},
+	})
+	batchRequest.Context(ctx)
+	_, err = batchRequest.Do()
+	return err
+}
+
+// readCells refreshes the managed notes and hyperlinks through the grid data of the range.
+func (r *RangeResource) readCells(ctx context.Context, data *RangeResourceModel) error {
+	getRequest := r.client.Spreadsheets.Get(data.SpreadsheetID.ValueString())
+	getRequest.Ranges(data.Range.ValueString())
+	getRequest.IncludeGridData(true)
+	getRequest.Fields("spreadsheetId,sheets(properties,data(startRow,startColumn,rowData.values(note,textFormatRuns)))")
+	getRequest.Context(ctx)
+	spreadsheet, err := getRequest.Do()
+	if err != nil {
+		return err
+	}
+
+	gridRange, err := GridRangeFromA1(spreadsheet, data.Range.ValueString())
+	if err != nil {
+		return err
+	}
+	sheet := FindSheetByID(spreadsheet, gridRange.SheetId)
+	if sheet == nil {
+		return fmt.Errorf("sheet %d not found in spreadsheet %s", gridRange.SheetId, spreadsheet.SpreadsheetId)
+	}
+
+	notes, hyperlinks := NotesAndHyperlinks(sheet, gridRange, data.MajorDimension.ValueString())
+	// The cells after the managed ones belong to someone else.
+	if values := data.ToInterface(); data.Shifts() {
+		if len(notes) > len(values) {
+			notes = notes[:len(values)]
+		}
+		if len(hyperlinks) > len(values) {
+			hyperlinks = hyperlinks[:len(values)]
+		}
+	}
+
+	if !data.Notes.IsNull() {
+		data.Notes = ValuesToList(KeepDimensions(ListToInterface(data.Notes), notes))
+	}
+	if !data.Hyperlinks.IsNull() {
+		data.Hyperlinks = ValuesToList(KeepDimensions(ListToInterface(data.Hyperlinks), hyperlinks))
+	}
+	return nil
+}
+
+// sort sorts the rows of the written values with the sort specs.
+func (r *RangeResource) sort(ctx context.Context, data *RangeResourceModel, values [][]interface{}) error {
+	// An empty rectangle has nothing to sort.
+	if len(values) == 0 {
+		return nil
+	}
+
+	getRequest := r.client.Spreadsheets.Get(data.SpreadsheetID.ValueString())
+	getRequest.Fields("spreadsheetId,sheets.properties")
+	getRequest.Context(ctx)
+	spreadsheet, err := getRequest.Do()
+	if err != nil {
+		return err
+	}
+
+	gridRange, err := GridRangeFromA1(spreadsheet, data.Range.ValueString())
+	if err != nil {
+		return err
+	}
+
+	batchRequest := r.client.Spreadsheets.BatchUpdate(data.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{BuildSortRequest(gridRange, data.MajorDimension.ValueString(), values, data.SortSpecs)},
+	})
+	batchRequest.Context(ctx)
+	_, err = batchRequest.Do()
+	return err
+}
+
+// shift inserts or deletes rows or columns when the number of values changed from the previous one.
+func (r *RangeResource) shift(ctx context.Context, data *RangeResourceModel, previous int64) error {
+	current := int64(len(data.ToInterface()))
+	if current == previous {
+		return nil
+	}
+
+	getRequest := r.client.Spreadsheets.Get(data.SpreadsheetID.ValueString())
+	getRequest.Fields("spreadsheetId,sheets.properties")
+	getRequest.Context(ctx)
+	spreadsheet, err := getRequest.Do()
+	if err != nil {
+		return err
+	}
+
+	gridRange, err := GridRangeFromA1(spreadsheet, data.Range.ValueString())
+	if err != nil {
+		return err
+	}
+
+	batchRequest := r.client.Spreadsheets.BatchUpdate(data.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{BuildShiftRequest(gridRange, data.MajorDimension.ValueString(), previous, current)},
+	})
+	batchRequest.Context(ctx)
+	_, err = batchRequest.Do()
+	return err
+}
+
+// protect adds a protected range over the cells. If there is a previous protection, it is updated to follow the range.
+// It returns the id of the protected range.
+func (r *RangeResource) protect(ctx context.Context, data *RangeResourceModel, previous *RangeProtectModel) (int64, error) {
+	getRequest := r.client.Spreadsheets.Get(data.SpreadsheetID.ValueString())
+	getRequest.Fields("spreadsheetId,sheets.properties")
+	getRequest.Context(ctx)
+	spreadsheet, err := getRequest.Do()
+	if err != nil {
+		return 0, err
+	}
+
+	gridRange, err := data.ProtectedGridRange(spreadsheet)
+	if err != nil {
+		return 0, err
+	}
+	data.Protect.Range, err = RefreshA1(spreadsheet, data.Protect.Range, gridRange)
+	if err != nil {
+		return 0, err
+	}
+
+	protection := data.ToProtectedRangeModel()
+	if previous != nil {
+		protection.ProtectedRangeID = previous.ProtectedRangeID
+	}
+	protectedRange, err := protection.ToProtectedRange(spreadsheet)
+	if err != nil {
+		return 0, err
+	}
+	protectedRange.Range = gridRange
+
+	request := &sheets.Request{
+		AddProtectedRange: &sheets.AddProtectedRangeRequest{
+			ProtectedRange: protectedRange,
+		},
+	}
+	if previous != nil {
+		request = &sheets.Request{
+			UpdateProtectedRange: &sheets.UpdateProtectedRangeRequest{
+				ProtectedRange: protectedRange,
+				Fields:         protection.Fields(),
+			},
+		}
+	}
+
+	batchRequest := r.client.Spreadsheets.BatchUpdate(data.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{request},
+	})
+	batchRequest.Context(ctx)
+	batchResponse, err := batchRequest.Do()
+	if err != nil {
+		return 0, err
+	}
+
+	if previous != nil {
+		return protectedRange.ProtectedRangeId, nil
+	}
+	return batchResponse.Replies[0].AddProtectedRange.ProtectedRange.ProtectedRangeId, nil
+}
+
+// unprotect removes the protected range over the cells.
+func (r *RangeResource) unprotect(ctx context.Context, data *RangeResourceModel) error {
+	deleteRequest := r.client.Spreadsheets.BatchUpdate(data.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{DeleteProtectedRange: &sheets.DeleteProtectedRangeRequest{
+				ProtectedRangeId: data.Protect.ProtectedRangeID.ValueInt64(),
+			}},
+		},
+	})
+	deleteRequest.Context(ctx)
+	_, err := deleteRequest.Do()
+	return err
+}
+
+// readProtection refreshes the protection of the cells. If it was removed, the protect attribute is cleared so it is planned again.
+func (r *RangeResource) readProtection(ctx context.Context, data *RangeResourceModel) error {
+	getRequest := r.client.Spreadsheets.Get(data.SpreadsheetID.ValueString())
+	getRequest.Fields("spreadsheetId,sheets(properties,protectedRanges)")
+	getRequest.Context(ctx)
+	spreadsheet, err := getRequest.Do()
+	if err != nil {
+		return err
+	}
+
+	protectedRange := FindProtectedRange(spreadsheet, data.Protect.ProtectedRangeID.ValueInt64())
+	if protectedRange == nil {
+		data.Protect = nil
+		return nil
+	}
+
+	protection := data.ToProtectedRangeModel()
+	err = protection.Refresh(spreadsheet, protectedRange)
+	if err != nil {
+		return err
+	}
+
+	data.Protect.Range = protection.Range
+	data.Protect.Description = protection.Description
+	data.Protect.WarningOnly = protection.WarningOnly
+	data.Protect.Editors = protection.Editors
+	return nil
+}
@@ -8,11 +8,15 @@ import (
 	"net/http/httptest"
 	"os"
 	"reflect"
+	"regexp"
+	"sort"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"google.golang.org/api/sheets/v4"
 )
 
@@ -460,7 +464,906 @@ resource "gsheets_range" "test_range" {
 	})
 }
 
+func TestAccRangeResource_Protect(t *testing.T) {
+	var storedValues [][]interface{}
+	var protectedRanges []*sheets.ProtectedRange
+	nextProtectedRangeID := int64(10)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("PUT /v4/spreadsheets/{spreadsheetId}/values/{range}", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		requestBody := &sheets.ValueRange{}
+		err := json.NewDecoder(r.Body).Decode(requestBody)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		storedValues = requestBody.Values
+
+		res := sheets.UpdateValuesResponse{
+			SpreadsheetId: r.PathValue("spreadsheetId"),
+		}
+		err = json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+	mux.HandleFunc("GET /v4/spreadsheets/{spreadsheetId}/values/{range}", func(w http.ResponseWriter, r *http.Request) {
+		res := sheets.ValueRange{
+			Range:  r.PathValue("range"),
+			Values: Clean(storedValues),
+		}
+		err := json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+	mux.HandleFunc("GET /v4/spreadsheets/{spreadsheetId}", func(w http.ResponseWriter, r *http.Request) {
+		res := sheets.Spreadsheet{
+			SpreadsheetId: r.PathValue("spreadsheetId"),
+			Sheets: []*sheets.Sheet{
+				{
+					Properties:      &sheets.SheetProperties{SheetId: 2, Title: "test title"},
+					ProtectedRanges: protectedRanges,
+				},
+			},
+		}
+		err := json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+	mux.HandleFunc("POST /v4/spreadsheets/{spreadsheetIdUpdate}", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		requestBody := &sheets.BatchUpdateSpreadsheetRequest{}
+		err := json.NewDecoder(r.Body).Decode(requestBody)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		res := sheets.BatchUpdateSpreadsheetResponse{
+			SpreadsheetId: strings.Split(r.PathValue("spreadsheetIdUpdate"), ":")[0],
+			Replies:       []*sheets.Response{{}},
+		}
+		request := requestBody.Requests[0]
+		switch {
+		case request.AddProtectedRange != nil:
+			protectedRange := request.AddProtectedRange.ProtectedRange
+			protectedRange.ProtectedRangeId = nextProtectedRangeID
+			nextProtectedRangeID++
+			protectedRanges = []*sheets.ProtectedRange{protectedRange}
+			res.Replies[0].AddProtectedRange = &sheets.AddProtectedRangeResponse{ProtectedRange: protectedRange}
+		case request.UpdateProtectedRange != nil:
+			protectedRanges = []*sheets.ProtectedRange{request.UpdateProtectedRange.ProtectedRange}
+		case request.DeleteProtectedRange != nil:
+			protectedRanges = nil
+		}
+
+		err = json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	protectedRows := func(rows int64) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			// Only the written cells are protected, not the whole columns of the range.
+			expected := &sheets.GridRange{SheetId: 2, StartRowIndex: 0, EndRowIndex: rows, StartColumnIndex: 0, EndColumnIndex: 3}
+			if len(protectedRanges) != 1 || !EqualGridRanges(protectedRanges[0].Range, expected) {
+				return fmt.Errorf("Expected protected range %+v, got %v", expected, protectedRanges)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_range" "test_range" {
+	spreadsheet_id = "test-spreadsheet-id"
+	range = "'test title'!A:C"
+	values = [
+		["a","b","c"],
+	]
+	protect = {
+		editors = {
+			users = ["admin@example.com"]
+		}
+	}
+}
+	`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_range.test_range", "protect.protected_range_id", "10"),
+					resource.TestCheckResourceAttr("gsheets_range.test_range", "protect.warning_only", "false"),
+					resource.TestCheckResourceAttr("gsheets_range.test_range", "protect.editors.users.#", "1"),
+					resource.TestCheckResourceAttr("gsheets_range.test_range", "protect.range", "'test title'!A1:C1"),
+					protectedRows(1),
+				),
+			},
+			{
+				// The protection follows the size of the values.
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_range" "test_range" {
+	spreadsheet_id = "test-spreadsheet-id"
+	range = "'test title'!A:C"
+	values = [
+		["a","b","c"],
+		["d","e","f"],
+		["g","h","i"],
+	]
+	protect = {
+		editors = {
+			users = ["admin@example.com"]
+		}
+	}
+}
+	`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_range.test_range", "protect.protected_range_id", "10"),
+					resource.TestCheckResourceAttr("gsheets_range.test_range", "protect.range", "'test title'!A1:C3"),
+					protectedRows(3),
+				),
+			},
+			{
+				// The protection was extended by hand to the whole columns, it must be restored.
+				PreConfig: func() {
+					protectedRanges[0].Range = &sheets.GridRange{SheetId: 2, StartColumnIndex: 0, EndColumnIndex: 3}
+				},
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_range" "test_range" {
+	spreadsheet_id = "test-spreadsheet-id"
+	range = "'test title'!A:C"
+	values = [
+		["a","b","c"],
+		["d","e","f"],
+		["g","h","i"],
+	]
+	protect = {
+		editors = {
+			users = ["admin@example.com"]
+		}
+	}
+}
+	`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_range.test_range", "protect.range", "'test title'!A1:C3"),
+					protectedRows(3),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_range" "test_range" {
+	spreadsheet_id = "test-spreadsheet-id"
+	range = "'test title'!A:C"
+	values = [
+		["a","b","c"],
+	]
+	protect = {
+		description = "managed by terraform"
+		warning_only = true
+	}
+}
+	`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_range.test_range", "protect.protected_range_id", "10"),
+					resource.TestCheckResourceAttr("gsheets_range.test_range", "protect.description", "managed by terraform"),
+					resource.TestCheckResourceAttr("gsheets_range.test_range", "protect.warning_only", "true"),
+					protectedRows(1),
+				),
+			},
+			{
+				// The protection was removed by hand, it must be created again.
+				PreConfig: func() {
+					protectedRanges = nil
+				},
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_range" "test_range" {
+	spreadsheet_id = "test-spreadsheet-id"
+	range = "'test title'!A:C"
+	values = [
+		["a","b","c"],
+	]
+	protect = {
+		description = "managed by terraform"
+		warning_only = true
+	}
+}
+	`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_range.test_range", "protect.protected_range_id", "11"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_range" "test_range" {
+	spreadsheet_id = "test-spreadsheet-id"
+	range = "'test title'!A:C"
+	values = [
+		["a","b","c"],
+	]
+}
+	`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("gsheets_range.test_range", "protect"),
+					func(s *terraform.State) error {
+						if len(protectedRanges) != 0 {
+							return fmt.Errorf("Expected protection to be removed, got %d protected ranges", len(protectedRanges))
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 // Relies on the existence of a document that the service account has access to.
+func TestAccRangeResource_DataFilter(t *testing.T) {
+	var storedValues [][]interface{}
+	// The tagged rows, they move when rows are inserted above them.
+	matchedRange := "'test title'!A5:Z6"
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v4/spreadsheets/{spreadsheetId}/values:batchUpdateByDataFilter", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		requestBody := &sheets.BatchUpdateValuesByDataFilterRequest{}
+		err := json.NewDecoder(r.Body).Decode(requestBody)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		lookup := requestBody.Data[0].DataFilter.DeveloperMetadataLookup
+		if lookup.MetadataKey != "owner" || lookup.MetadataValue != "terraform" {
+			t.Errorf("Unexpected lookup %v", lookup)
+		}
+		storedValues = requestBody.Data[0].Values
+
+		res := sheets.BatchUpdateValuesByDataFilterResponse{
+			SpreadsheetId: r.PathValue("spreadsheetId"),
+		}
+		err = json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+	mux.HandleFunc("POST /v4/spreadsheets/{spreadsheetId}/values:batchGetByDataFilter", func(w http.ResponseWriter, r *http.Request) {
+		res := sheets.BatchGetValuesByDataFilterResponse{
+			SpreadsheetId: r.PathValue("spreadsheetId"),
+			ValueRanges: []*sheets.MatchedValueRange{
+				{ValueRange: &sheets.ValueRange{Range: matchedRange, Values: storedValues}},
+			},
+		}
+		err := json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	config := fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_range" "test" {
+	spreadsheet_id = "test-spreadsheet-id"
+	data_filter = {
+		metadata_key = "owner"
+		metadata_value = "terraform"
+	}
+	values = [
+		["svc-deploy", "admin"],
+		["svc-backup", "reader"],
+	]
+}
+`, server.URL)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_range.test", "range", "'test title'!A5:Z6"),
+					resource.TestCheckResourceAttr("gsheets_range.test", "values.1.0", "svc-backup"),
+				),
+			},
+			{
+				// Someone inserted rows above, the cells are still found and nothing changes.
+				PreConfig: func() {
+					matchedRange = "'test title'!A8:Z9"
+				},
+				Config:   config,
+				PlanOnly: true,
+			},
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_range.test", "range", "'test title'!A8:Z9"),
+				),
+			},
+			{
+				// The cells were changed by hand, they are written again through the filter.
+				PreConfig: func() {
+					storedValues[0][1] = "reader"
+				},
+				Config: config,
+				Check: func(s *terraform.State) error {
+					if storedValues[0][1] != "admin" {
+						return fmt.Errorf("Expected the values to be restored, got %v", storedValues)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccRangeResource_InsertModeShift(t *testing.T) {
+	// The cells of the sheet by row, the managed range starts at the second row, where the footer is.
+	grid := [][]interface{}{{"name", "role"}, {"footer"}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("PUT /v4/spreadsheets/{spreadsheetId}/values/{range}", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		requestBody := &sheets.ValueRange{}
+		err := json.NewDecoder(r.Body).Decode(requestBody)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		for i, row := range requestBody.Values {
+			grid[i+1] = row
+		}
+
+		err = json.NewEncoder(w).Encode(sheets.UpdateValuesResponse{SpreadsheetId: r.PathValue("spreadsheetId")})
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+	mux.HandleFunc("GET /v4/spreadsheets/{spreadsheetId}/values/{range}", func(w http.ResponseWriter, r *http.Request) {
+		values := [][]interface{}{}
+		for _, row := range grid[1:] {
+			values = append(values, append([]interface{}{}, row...))
+		}
+		err := json.NewEncoder(w).Encode(sheets.ValueRange{Range: r.PathValue("range"), Values: Clean(values)})
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+	mux.HandleFunc("GET /v4/spreadsheets/{spreadsheetId}", func(w http.ResponseWriter, r *http.Request) {
+		res := sheets.Spreadsheet{
+			SpreadsheetId: r.PathValue("spreadsheetId"),
+			Sheets: []*sheets.Sheet{
+				{Properties: &sheets.SheetProperties{SheetId: 2, Title: "test title"}},
+			},
+		}
+		err := json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+	mux.HandleFunc("POST /v4/spreadsheets/{spreadsheetIdUpdate}", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		requestBody := &sheets.BatchUpdateSpreadsheetRequest{}
+		err := json.NewDecoder(r.Body).Decode(requestBody)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		request := requestBody.Requests[0]
+		switch {
+		case request.InsertDimension != nil:
+			dimensionRange := request.InsertDimension.Range
+			if dimensionRange.SheetId != 2 || dimensionRange.Dimension != "ROWS" || !request.InsertDimension.InheritFromBefore {
+				t.Errorf("Unexpected insert %v", request.InsertDimension)
+			}
+			inserted := make([][]interface{}, dimensionRange.EndIndex-dimensionRange.StartIndex)
+			grid = append(grid[:dimensionRange.StartIndex], append(inserted, grid[dimensionRange.StartIndex:]...)...)
+		case request.DeleteDimension != nil:
+			dimensionRange := request.DeleteDimension.Range
+			grid = append(grid[:dimensionRange.StartIndex], grid[dimensionRange.EndIndex:]...)
+		default:
+			t.Errorf("Unexpected request %v", request)
+		}
+
+		err = json.NewEncoder(w).Encode(sheets.BatchUpdateSpreadsheetResponse{})
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	config := func(values string) string {
+		return fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_range" "test" {
+	spreadsheet_id = "test-spreadsheet-id"
+	range = "'test title'!A2:B"
+	insert_mode = "shift"
+	values = %s
+}
+`, server.URL, values)
+	}
+
+	footerAt := func(row int) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			if len(grid) <= row || len(grid[row]) == 0 || grid[row][0] != "footer" {
+				return fmt.Errorf("Expected the footer at row %d, got %v", row, grid)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		Steps: []resource.TestStep{
+			{
+				Config: config(`[["alice", "admin"], ["bob", "reader"]]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_range.test", "values.#", "2"),
+					footerAt(3),
+				),
+			},
+			{
+				Config: config(`[["alice", "admin"], ["bob", "reader"], ["carol", "reader"]]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_range.test", "values.#", "3"),
+					footerAt(4),
+				),
+			},
+			{
+				Config: config(`[["alice", "admin"]]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_range.test", "values.#", "1"),
+					footerAt(2),
+				),
+			},
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			// The rows of the values are deleted, the footer goes back to where it was.
+			if len(grid) != 2 {
+				return fmt.Errorf("Expected the rows of the values to be deleted, got %v", grid)
+			}
+			return footerAt(1)(s)
+		},
+	})
+}
+
+func TestAccRangeResource_NotesAndHyperlinks(t *testing.T) {
+	// The cells of the sheet by row, the managed range starts at the second row.
+	grid := [][]*sheets.CellData{{}, {{}, {}}, {{}, {}}}
+	values := [][]interface{}{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("PUT /v4/spreadsheets/{spreadsheetId}/values/{range}", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		requestBody := &sheets.ValueRange{}
+		err := json.NewDecoder(r.Body).Decode(requestBody)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		values = requestBody.Values
+
+		err = json.NewEncoder(w).Encode(sheets.UpdateValuesResponse{SpreadsheetId: r.PathValue("spreadsheetId")})
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+	mux.HandleFunc("GET /v4/spreadsheets/{spreadsheetId}/values/{range}", func(w http.ResponseWriter, r *http.Request) {
+		err := json.NewEncoder(w).Encode(sheets.ValueRange{Range: r.PathValue("range"), Values: Clean(values)})
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+	mux.HandleFunc("GET /v4/spreadsheets/{spreadsheetId}", func(w http.ResponseWriter, r *http.Request) {
+		sheet := &sheets.Sheet{Properties: &sheets.SheetProperties{SheetId: 2, Title: "test title"}}
+		if r.URL.Query().Get("includeGridData") == "true" {
+			data := &sheets.GridData{StartRow: 1}
+			for _, row := range grid[1:] {
+				data.RowData = append(data.RowData, &sheets.RowData{Values: row})
+			}
+			sheet.Data = []*sheets.GridData{data}
+		}
+		err := json.NewEncoder(w).Encode(sheets.Spreadsheet{SpreadsheetId: r.PathValue("spreadsheetId"), Sheets: []*sheets.Sheet{sheet}})
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+	mux.HandleFunc("POST /v4/spreadsheets/{spreadsheetIdUpdate}", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		requestBody := &sheets.BatchUpdateSpreadsheetRequest{}
+		err := json.NewDecoder(r.Body).Decode(requestBody)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		update := requestBody.Requests[0].UpdateCells
+		if update == nil || update.Start.SheetId != 2 || update.Start.RowIndex != 1 || update.Start.ColumnIndex != 0 {
+			t.Errorf("Unexpected request %v", requestBody.Requests[0])
+		} else {
+			for i, row := range update.Rows {
+				for j, cell := range row.Values {
+					if strings.Contains(update.Fields, "note") {
+						grid[int(update.Start.RowIndex)+i][j].Note = cell.Note
+					}
+					if strings.Contains(update.Fields, "textFormatRuns") {
+						grid[int(update.Start.RowIndex)+i][j].TextFormatRuns = cell.TextFormatRuns
+					}
+				}
+			}
+		}
+
+		err = json.NewEncoder(w).Encode(sheets.BatchUpdateSpreadsheetResponse{})
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	config := func(cells string) string {
+		return fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_range" "test" {
+	spreadsheet_id = "test-spreadsheet-id"
+	range = "'test title'!A2:B3"
+	values = [["alice", "alice@example.com"], ["bob", "bob@example.com"]]
+	%s
+}
+`, server.URL, cells)
+	}
+
+	link := func(cell *sheets.CellData) string {
+		if len(cell.TextFormatRuns) == 0 {
+			return ""
+		}
+		return cell.TextFormatRuns[0].Format.Link.Uri
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		CheckDestroy: func(s *terraform.State) error {
+			for _, row := range grid {
+				for _, cell := range row {
+					if cell.Note != "" || len(cell.TextFormatRuns) != 0 {
+						return fmt.Errorf("Expected the cells to be cleared, got %v", cell)
+					}
+				}
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(`
+	notes = [["approved by carol"]]
+	hyperlinks = [["", "mailto:alice@example.com"], ["", "mailto:bob@example.com"]]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_range.test", "notes.0.0", "approved by carol"),
+					resource.TestCheckResourceAttr("gsheets_range.test", "hyperlinks.1.1", "mailto:bob@example.com"),
+					func(s *terraform.State) error {
+						if grid[1][0].Note != "approved by carol" || link(grid[1][1]) != "mailto:alice@example.com" || link(grid[2][1]) != "mailto:bob@example.com" {
+							return fmt.Errorf("Unexpected cells %v", grid)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// Someone removed the note and added another one, the managed cells are restored.
+				PreConfig: func() {
+					grid[1][0].Note = ""
+					grid[2][0].Note = "looks fine"
+				},
+				Config: config(`
+	notes = [["approved by carol"]]
+	hyperlinks = [["", "mailto:alice@example.com"], ["", "mailto:bob@example.com"]]`),
+				Check: func(s *terraform.State) error {
+					if grid[1][0].Note != "approved by carol" || grid[2][0].Note != "" {
+						return fmt.Errorf("Unexpected notes %v %v", grid[1][0], grid[2][0])
+					}
+					return nil
+				},
+			},
+			{
+				// Links are no longer managed, so the previous ones are removed.
+				Config: config(`
+	notes = [[""], ["approved by dave"]]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("gsheets_range.test", "hyperlinks"),
+					func(s *terraform.State) error {
+						if grid[1][0].Note != "" || grid[2][0].Note != "approved by dave" || link(grid[1][1]) != "" || link(grid[2][1]) != "" {
+							return fmt.Errorf("Unexpected cells %v", grid)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config:   config(`notes = [[""], ["approved by dave"]]`),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccRangeResource_SortSpecs(t *testing.T) {
+	// The values of the sheet, the managed range starts at the second row.
+	values := [][]interface{}{}
+	var sortRange *sheets.SortRangeRequest
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("PUT /v4/spreadsheets/{spreadsheetId}/values/{range}", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		requestBody := &sheets.ValueRange{}
+		err := json.NewDecoder(r.Body).Decode(requestBody)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		values = requestBody.Values
+
+		err = json.NewEncoder(w).Encode(sheets.UpdateValuesResponse{SpreadsheetId: r.PathValue("spreadsheetId")})
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+	mux.HandleFunc("GET /v4/spreadsheets/{spreadsheetId}/values/{range}", func(w http.ResponseWriter, r *http.Request) {
+		err := json.NewEncoder(w).Encode(sheets.ValueRange{Range: r.PathValue("range"), Values: Clean(values)})
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+	mux.HandleFunc("GET /v4/spreadsheets/{spreadsheetId}", func(w http.ResponseWriter, r *http.Request) {
+		sheet := &sheets.Sheet{Properties: &sheets.SheetProperties{SheetId: 2, Title: "test title"}}
+		err := json.NewEncoder(w).Encode(sheets.Spreadsheet{SpreadsheetId: r.PathValue("spreadsheetId"), Sheets: []*sheets.Sheet{sheet}})
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+	mux.HandleFunc("POST /v4/spreadsheets/{spreadsheetIdUpdate}", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		requestBody := &sheets.BatchUpdateSpreadsheetRequest{}
+		err := json.NewDecoder(r.Body).Decode(requestBody)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		sortRange = requestBody.Requests[0].SortRange
+		if sortRange == nil || len(sortRange.SortSpecs) != 1 {
+			t.Errorf("Unexpected request %v", requestBody.Requests[0])
+		} else {
+			// The mock only sorts by the first column of the managed range.
+			rows := values[sortRange.Range.StartRowIndex-1 : sortRange.Range.EndRowIndex-1]
+			sort.SliceStable(rows, func(i, j int) bool {
+				return fmt.Sprint(rows[i][0]) < fmt.Sprint(rows[j][0])
+			})
+		}
+
+		err = json.NewEncoder(w).Encode(sheets.BatchUpdateSpreadsheetResponse{})
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	config := func(rows string) string {
+		return fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_range" "test" {
+	spreadsheet_id = "test-spreadsheet-id"
+	range = "'test title'!A2:B"
+	values = %s
+	sort_specs = [{ column_index = 0, sort_order = "ASCENDING" }]
+}
+`, server.URL, rows)
+	}
+
+	first := func(values [][]interface{}) string {
+		var names []string
+		for _, row := range values {
+			names = append(names, fmt.Sprint(row[0]))
+		}
+		return strings.Join(names, ",")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		Steps: []resource.TestStep{
+			{
+				// Sorting moves the notes and the hyperlinks along with their rows, they can't be compared by position.
+				Config: `
+resource "gsheets_range" "test" {
+	spreadsheet_id = "test-spreadsheet-id"
+	range = "'test title'!A2:B"
+	values = [["carol", "3"], ["alice", "1"]]
+	notes = [["", "checked"], ["", ""]]
+	sort_specs = [{ column_index = 0, sort_order = "ASCENDING" }]
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config: config(`[["carol", "3"], ["alice", "1"]]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_range.test", "values.0.0", "carol"),
+					func(s *terraform.State) error {
+						if r := sortRange.Range; r.SheetId != 2 || r.StartRowIndex != 1 || r.EndRowIndex != 3 || r.StartColumnIndex != 0 || r.EndColumnIndex != 2 {
+							return fmt.Errorf("Unexpected sort range %v", r)
+						}
+						if names := first(values); names != "alice,carol" {
+							return fmt.Errorf("Expected the rows to be sorted, got %s", names)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config:   config(`[["carol", "3"], ["alice", "1"]]`),
+				PlanOnly: true,
+			},
+			{
+				// A row is added to the configuration, the rows are sorted again.
+				Config: config(`[["carol", "3"], ["alice", "1"], ["bob", "2"]]`),
+				Check: func(s *terraform.State) error {
+					if names := first(values); names != "alice,bob,carol" {
+						return fmt.Errorf("Expected the rows to be sorted, got %s", names)
+					}
+					return nil
+				},
+			},
+			{
+				// Someone changed a value from the UI, it is written and sorted again.
+				PreConfig: func() {
+					values[0] = []interface{}{"zoe", "1"}
+				},
+				Config: config(`[["carol", "3"], ["alice", "1"], ["bob", "2"]]`),
+				Check: func(s *terraform.State) error {
+					if names := first(values); names != "alice,bob,carol" {
+						return fmt.Errorf("Expected the rows to be restored, got %s", names)
+					}
+					return nil
+				},
+			},
+			{
+				// A row appended after the managed ones is not sorted nor managed.
+				PreConfig: func() {
+					values = append(values, []interface{}{"aaron", "0"})
+				},
+				Config:   config(`[["carol", "3"], ["alice", "1"], ["bob", "2"]]`),
+				PlanOnly: true,
+			},
+			{
+				// Without values there is nothing to sort, an empty range must not be sent.
+				PreConfig: func() {
+					sortRange = nil
+				},
+				Config: config(`[]`),
+				Check: func(s *terraform.State) error {
+					if sortRange != nil {
+						return fmt.Errorf("Unexpected sort of %v", sortRange.Range)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
 func TestIntegrationRangeResource_RowChanges(t *testing.T) {
 	configVars := config.Variables{
 		"service_account_credentials": config.StringVariable(os.Getenv("SERVICE_ACCOUNT_CREDENTIALS")),
@@ -849,3 +1752,101 @@ func TestKeepDimensions(t *testing.T) {
 		})
 	}
 }
+
+func TestTranspose(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   [][]interface{}
+		expected [][]interface{}
+	}{
+		{
+			name:     "Empty",
+			values:   [][]interface{}{},
+			expected: [][]interface{}{},
+		},
+		{
+			name:     "Square",
+			values:   [][]interface{}{{1, 2}, {3, 4}},
+			expected: [][]interface{}{{1, 3}, {2, 4}},
+		},
+		{
+			name:     "Short rows",
+			values:   [][]interface{}{{1}, {2, 3}},
+			expected: [][]interface{}{{1, 2}, {"", 3}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Transpose(tt.values)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("got %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSameRows(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        [][]interface{}
+		b        [][]interface{}
+		expected bool
+	}{
+		{
+			name:     "Same order",
+			a:        [][]interface{}{{"a", "1"}, {"b", "2"}},
+			b:        [][]interface{}{{"a", "1"}, {"b", "2"}},
+			expected: true,
+		},
+		{
+			name:     "Different order",
+			a:        [][]interface{}{{"b", "2"}, {"a", "1"}},
+			b:        [][]interface{}{{"a", "1"}, {"b", "2"}},
+			expected: true,
+		},
+		{
+			name:     "Trailing empty strings",
+			a:        [][]interface{}{{"a", ""}, {"b", "2"}},
+			b:        [][]interface{}{{"b", "2"}, {"a"}},
+			expected: true,
+		},
+		{
+			name:     "Different values",
+			a:        [][]interface{}{{"a", "1"}, {"b", "2"}},
+			b:        [][]interface{}{{"a", "1"}, {"b", "3"}},
+			expected: false,
+		},
+		{
+			name:     "Repeated rows",
+			a:        [][]interface{}{{"a"}, {"a"}, {"b"}},
+			b:        [][]interface{}{{"a"}, {"b"}, {"b"}},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := SameRows(tt.a, tt.b)
+			if result != tt.expected {
+				t.Errorf("got %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBuildSortRequest(t *testing.T) {
+	request := BuildSortRequest(&sheets.GridRange{SheetId: 0}, "ROWS", [][]interface{}{{"b", "2"}, {"a", "1"}}, []SortSpecModel{
+		{ColumnIndex: types.Int64Value(0), SortOrder: types.StringValue("ASCENDING")},
+	})
+
+	body, err := json.Marshal(request.SortRange.Range)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Zero indexes must be sent, otherwise the range is unbounded and the whole sheet is sorted.
+	expected := `{"endColumnIndex":2,"endRowIndex":2,"sheetId":0,"startColumnIndex":0,"startRowIndex":0}`
+	if string(body) != expected {
+		t.Errorf("Expected %s, got %s", expected, body)
+	}
+}
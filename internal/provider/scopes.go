@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+const (
+	ScopeSpreadsheets         = "https://www.googleapis.com/auth/spreadsheets"
+	ScopeSpreadsheetsReadOnly = "https://www.googleapis.com/auth/spreadsheets.readonly"
+)
+
+// IsReadRequest reports whether the request only reads data, so a read-only scope is enough.
+// Reading by data filter is done with POST.
+func IsReadRequest(req *http.Request) bool {
+	return req.Method == http.MethodGet || strings.HasSuffix(req.URL.Path, ":getByDataFilter") || strings.HasSuffix(req.URL.Path, ":batchGetByDataFilter")
+}
+
+// scopedTransport sends each request with a token of the least privileged scope it needs.
+type scopedTransport struct {
+	read  http.RoundTripper
+	write http.RoundTripper
+}
+
+func (t *scopedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if IsReadRequest(req) {
+		return t.read.RoundTrip(req)
+	}
+	return t.write.RoundTrip(req)
+}
+
+var insufficientScopeRegexp = regexp.MustCompile(`error="insufficient_scope"`)
+var requiredScopesRegexp = regexp.MustCompile(`scope="([^"]*)"`)
+
+// insufficientScopeTransport names the missing scopes when google rejects a token for them.
+type insufficientScopeTransport struct {
+	base http.RoundTripper
+}
+
+func (t *insufficientScopeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := t.base.RoundTrip(req)
+	if err != nil || res.StatusCode != http.StatusForbidden {
+		return res, err
+	}
+	authenticate := res.Header.Get("WWW-Authenticate")
+	if !insufficientScopeRegexp.MatchString(authenticate) {
+		return res, err
+	}
+	res.Body.Close()
+	return nil, fmt.Errorf("the access token is missing the scope to %s: add one of %s to scopes", describeRequest(req), strings.Join(RequiredScopes(req, authenticate), ", "))
+}
+
+// RequiredScopes returns the scopes that google accepts for the request, as listed in the WWW-Authenticate header.
+// If the header doesn't list them, the default scope of the request is returned.
+func RequiredScopes(req *http.Request, authenticate string) []string {
+	match := requiredScopesRegexp.FindStringSubmatch(authenticate)
+	if match != nil && match[1] != "" {
+		return strings.Fields(match[1])
+	}
+	if IsReadRequest(req) {
+		return []string{ScopeSpreadsheetsReadOnly}
+	}
+	return []string{ScopeSpreadsheets}
+}
+
+func describeRequest(req *http.Request) string {
+	if IsReadRequest(req) {
+		return "read the spreadsheet"
+	}
+	return "write the spreadsheet"
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestRequiredScopes(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		target       string
+		authenticate string
+		expected     []string
+	}{
+		{
+			name:         "Listed in the header",
+			method:       "POST",
+			target:       "/v4/spreadsheets/id:batchUpdate",
+			authenticate: `Bearer realm="https://accounts.google.com/", error="insufficient_scope", scope="https://www.googleapis.com/auth/spreadsheets https://www.googleapis.com/auth/drive"`,
+			expected:     []string{ScopeSpreadsheets, "https://www.googleapis.com/auth/drive"},
+		},
+		{
+			name:         "Write",
+			method:       "PUT",
+			target:       "/v4/spreadsheets/id/values/A1",
+			authenticate: `Bearer error="insufficient_scope"`,
+			expected:     []string{ScopeSpreadsheets},
+		},
+		{
+			name:         "Read",
+			method:       "GET",
+			target:       "/v4/spreadsheets/id/values/A1",
+			authenticate: `Bearer error="insufficient_scope"`,
+			expected:     []string{ScopeSpreadsheetsReadOnly},
+		},
+		{
+			name:         "Read by data filter",
+			method:       "POST",
+			target:       "/v4/spreadsheets/id/values:batchGetByDataFilter",
+			authenticate: `Bearer error="insufficient_scope"`,
+			expected:     []string{ScopeSpreadsheetsReadOnly},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := RequiredScopes(httptest.NewRequest(tt.method, tt.target, nil), tt.authenticate)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("got %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
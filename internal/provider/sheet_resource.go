@@ -34,6 +34,43 @@ type SpreadsheetPropertiesModel struct {
 type SheetsResourceModel struct {
 	SpreadsheetID types.String                `tfsdk:"spreadsheet_id"`
 	Properties    *SpreadsheetPropertiesModel `tfsdk:"properties"`
+	BasicFilter   *BasicFilterModel           `tfsdk:"basic_filter"`
+}
+
+type BasicFilterModel struct {
+	Range       types.String      `tfsdk:"range"`
+	SortSpecs   []SortSpecModel   `tfsdk:"sort_specs"`
+	FilterSpecs []FilterSpecModel `tfsdk:"filter_specs"`
+}
+
+// ToBasicFilter converts the model into the API representation for the given sheet.
+func (m *BasicFilterModel) ToBasicFilter(sheetID int64) (*sheets.BasicFilter, error) {
+	gridRange, err := ParseCells(m.Range.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	gridRange.SheetId = sheetID
+
+	return &sheets.BasicFilter{
+		Range:       gridRange,
+		SortSpecs:   ToSortSpecs(m.SortSpecs),
+		FilterSpecs: ToFilterSpecs(m.FilterSpecs),
+	}, nil
+}
+
+// Refresh updates the model with the basic filter returned by the API.
+func (m *BasicFilterModel) Refresh(filter *sheets.BasicFilter) {
+	// A filter over the whole sheet is returned with the size of the sheet, so a null range is kept null.
+	if !m.Range.IsNull() && filter.Range != nil {
+		current, err := ParseCells(m.Range.ValueString())
+		returned := *filter.Range
+		returned.SheetId = 0
+		if err != nil || !EqualGridRanges(current, &returned) {
+			m.Range = types.StringValue(FormatCells(filter.Range))
+		}
+	}
+	m.SortSpecs = RefreshSortSpecs(m.SortSpecs, filter.SortSpecs)
+	m.FilterSpecs = RefreshFilterSpecs(m.FilterSpecs, filter.FilterSpecs)
 }
 
 func (r *SheetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -69,6 +106,18 @@ This is useful to create an extranet if you need more than one. `,
 					},
 				},
 			},
+			"basic_filter": schema.SingleNestedAttribute{
+				MarkdownDescription: "The default filter of the sheet, visible to everyone",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"range": schema.StringAttribute{
+						MarkdownDescription: "The range to filter in A1 notation without the sheet title, such as `A1:F`. Defaults to the whole sheet.",
+						Optional:            true,
+					},
+					"sort_specs":   sortSpecsAttribute("The sort order of the rows. Later specifications are used when the values of the previous columns are equal."),
+					"filter_specs": filterSpecsAttribute("The criteria used to show or hide rows"),
+				},
+			},
 		},
 	}
 }
@@ -126,9 +175,44 @@ func (r *SheetResource) Create(ctx context.Context, req resource.CreateRequest,
 	data.Properties.Index = basetypes.NewInt64Value(createResponse.Replies[0].AddSheet.Properties.Index)
 	data.Properties.SheetID = basetypes.NewInt64Value(createResponse.Replies[0].AddSheet.Properties.SheetId)
 
+	// The sheet must exist before the filter can reference its id.
+	if data.BasicFilter != nil {
+		request, err := basicFilterRequest(data.Properties.SheetID.ValueInt64(), data.BasicFilter)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid basic filter", err.Error())
+			return
+		}
+		filterRequest := r.client.Spreadsheets.BatchUpdate(data.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
+			Requests: []*sheets.Request{request},
+		})
+		filterRequest.Context(ctx)
+		_, err = filterRequest.Do()
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to set basic filter", err.Error())
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// basicFilterRequest sets the basic filter of the sheet, or clears it when there is no filter.
+func basicFilterRequest(sheetID int64, filter *BasicFilterModel) (*sheets.Request, error) {
+	if filter == nil {
+		return &sheets.Request{ClearBasicFilter: &sheets.ClearBasicFilterRequest{
+			SheetId: sheetID,
+		}}, nil
+	}
+
+	basicFilter, err := filter.ToBasicFilter(sheetID)
+	if err != nil {
+		return nil, err
+	}
+	return &sheets.Request{SetBasicFilter: &sheets.SetBasicFilterRequest{
+		Filter: basicFilter,
+	}}, nil
+}
+
 // ImportState implements resource.ResourceWithImportState.
 func (r *SheetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	var data SheetsResourceModel
@@ -176,6 +260,34 @@ func (r *SheetResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Only the basic filter is refreshed, so it is only read when it is managed.
+	if data.BasicFilter == nil {
+		return
+	}
+
+	getRequest := r.client.Spreadsheets.Get(data.SpreadsheetID.ValueString())
+	getRequest.Fields("spreadsheetId,sheets(properties,basicFilter)")
+	getRequest.Context(ctx)
+	spreadsheet, err := getRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read data,", err.Error())
+		return
+	}
+
+	sheet := FindSheetByID(spreadsheet, data.Properties.SheetID.ValueInt64())
+	if sheet == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if sheet.BasicFilter == nil {
+		data.BasicFilter = nil
+	} else {
+		data.BasicFilter.Refresh(sheet.BasicFilter)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 // Update is called to update the state of the resource. Config, planned
@@ -197,17 +309,30 @@ func (r *SheetResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	updateRequest := r.client.Spreadsheets.BatchUpdate(stateData.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
-		Requests: []*sheets.Request{
-			{
-				UpdateSheetProperties: &sheets.UpdateSheetPropertiesRequest{
-					Properties: &sheets.SheetProperties{
-						Title: planData.Properties.Title.ValueString(),
-					},
+	requests := []*sheets.Request{
+		{
+			UpdateSheetProperties: &sheets.UpdateSheetPropertiesRequest{
+				Properties: &sheets.SheetProperties{
+					SheetId: stateData.Properties.SheetID.ValueInt64(),
+					Title:   planData.Properties.Title.ValueString(),
 				},
+				Fields: "title",
 			},
 		},
+	}
+	if planData.BasicFilter != nil || stateData.BasicFilter != nil {
+		request, err := basicFilterRequest(stateData.Properties.SheetID.ValueInt64(), planData.BasicFilter)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid basic filter", err.Error())
+			return
+		}
+		requests = append(requests, request)
+	}
+
+	updateRequest := r.client.Spreadsheets.BatchUpdate(stateData.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: requests,
 	})
+	updateRequest.Context(ctx)
 	updateResponse, err := updateRequest.Do()
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to perform update request", err.Error())
@@ -215,7 +340,8 @@ func (r *SheetResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	stateData.SpreadsheetID = basetypes.NewStringValue(updateResponse.SpreadsheetId)
-	stateData.Properties.Title = basetypes.NewStringValue(updateResponse.Replies[0].AddSheet.Properties.Title)
+	stateData.Properties.Title = planData.Properties.Title
+	stateData.BasicFilter = planData.BasicFilter
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateData)...)
 }
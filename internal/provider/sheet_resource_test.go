@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"google.golang.org/api/sheets/v4"
 )
 
@@ -145,3 +146,157 @@ resource "gsheets_sheet" "test" {
 		},
 	})
 }
+
+func TestAccSheetResource_BasicFilter(t *testing.T) {
+	var basicFilter *sheets.BasicFilter
+	var requests []*sheets.Request
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v4/spreadsheets/{spreadsheetId}", func(w http.ResponseWriter, r *http.Request) {
+		res := sheets.Spreadsheet{
+			SpreadsheetId: r.PathValue("spreadsheetId"),
+			Sheets: []*sheets.Sheet{
+				{
+					Properties:  &sheets.SheetProperties{SheetId: 2, Title: "roster"},
+					BasicFilter: basicFilter,
+				},
+			},
+		}
+		err := json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+	mux.HandleFunc("POST /v4/spreadsheets/{spreadsheetIdUpdate}", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		requestBody := &sheets.BatchUpdateSpreadsheetRequest{}
+		err := json.NewDecoder(r.Body).Decode(requestBody)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		requests = requestBody.Requests
+		res := sheets.BatchUpdateSpreadsheetResponse{
+			SpreadsheetId: strings.Split(r.PathValue("spreadsheetIdUpdate"), ":")[0],
+		}
+		for _, request := range requestBody.Requests {
+			reply := &sheets.Response{}
+			switch {
+			case request.AddSheet != nil:
+				reply.AddSheet = &sheets.AddSheetResponse{
+					Properties: &sheets.SheetProperties{Index: 1, SheetId: 2, Title: request.AddSheet.Properties.Title},
+				}
+			case request.SetBasicFilter != nil:
+				if request.SetBasicFilter.Filter.Range.SheetId != 2 {
+					t.Errorf("Unexpected sheet %d", request.SetBasicFilter.Filter.Range.SheetId)
+				}
+				basicFilter = request.SetBasicFilter.Filter
+			case request.ClearBasicFilter != nil:
+				basicFilter = nil
+			}
+			res.Replies = append(res.Replies, reply)
+		}
+
+		err = json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	config := fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_sheet" "test" {
+	spreadsheet_id = "test-spreadsheet-id"
+	properties = {
+		title = "roster"
+	}
+	basic_filter = {
+		range = "A1:D"
+		sort_specs = [{
+			column_index = 1
+			sort_order = "DESCENDING"
+		}]
+		filter_specs = [{
+			column_index = 3
+			hidden_values = ["inactive"]
+		}]
+	}
+}`, server.URL)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_sheet.test", "basic_filter.range", "A1:D"),
+					resource.TestCheckResourceAttr("gsheets_sheet.test", "basic_filter.sort_specs.0.sort_order", "DESCENDING"),
+					resource.TestCheckResourceAttr("gsheets_sheet.test", "basic_filter.filter_specs.0.hidden_values.0", "inactive"),
+					func(s *terraform.State) error {
+						if basicFilter == nil || basicFilter.Range.EndColumnIndex != 4 {
+							return fmt.Errorf("Expected the basic filter to be set, got %v", basicFilter)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// Someone removed the filter by hand, it must be set again.
+				PreConfig: func() {
+					basicFilter = nil
+				},
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gsheets_sheet.test", "basic_filter.range", "A1:D"),
+					func(s *terraform.State) error {
+						if basicFilter == nil {
+							return fmt.Errorf("Expected the basic filter to be set again")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_sheet" "test" {
+	spreadsheet_id = "test-spreadsheet-id"
+	properties = {
+		title = "roster"
+	}
+}`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("gsheets_sheet.test", "basic_filter"),
+					func(s *terraform.State) error {
+						if basicFilter != nil {
+							return fmt.Errorf("Expected the basic filter to be cleared")
+						}
+						if requests[0].UpdateSheetProperties.Properties.SheetId != 2 {
+							return fmt.Errorf("Expected the update to target the sheet")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
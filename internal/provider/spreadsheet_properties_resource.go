@@ -0,0 +1,410 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/api/sheets/v4"
+)
+
+var _ resource.ResourceWithConfigure = &SpreadsheetPropertiesResource{}
+
+func NewSpreadsheetPropertiesResource() resource.Resource {
+	return &SpreadsheetPropertiesResource{}
+}
+
+type SpreadsheetPropertiesResource struct {
+	client *sheets.Service
+}
+
+type SpreadsheetPropertiesResourceModel struct {
+	SpreadsheetID                types.String                       `tfsdk:"spreadsheet_id"`
+	Title                        types.String                       `tfsdk:"title"`
+	Locale                       types.String                       `tfsdk:"locale"`
+	TimeZone                     types.String                       `tfsdk:"time_zone"`
+	AutoRecalc                   types.String                       `tfsdk:"auto_recalc"`
+	IterativeCalculationSettings *IterativeCalculationSettingsModel `tfsdk:"iterative_calculation_settings"`
+	DefaultFormat                *CellFormatModel                   `tfsdk:"default_format"`
+	SpreadsheetTheme             *SpreadsheetThemeModel             `tfsdk:"spreadsheet_theme"`
+}
+
+type IterativeCalculationSettingsModel struct {
+	MaxIterations        types.Int64   `tfsdk:"max_iterations"`
+	ConvergenceThreshold types.Float64 `tfsdk:"convergence_threshold"`
+}
+
+type SpreadsheetThemeModel struct {
+	PrimaryFontFamily types.String            `tfsdk:"primary_font_family"`
+	ThemeColors       map[string]types.String `tfsdk:"theme_colors"`
+}
+
+// ThemeColorTypes are the colors of a spreadsheet theme.
+var ThemeColorTypes = []string{"TEXT", "BACKGROUND", "ACCENT1", "ACCENT2", "ACCENT3", "ACCENT4", "ACCENT5", "ACCENT6", "LINK"}
+
+// ToSpreadsheetTheme applies the managed attributes over the current theme of the spreadsheet.
+// Google sheets requires every theme color when the theme is updated, so the ones that are not managed are kept.
+func (m *SpreadsheetThemeModel) ToSpreadsheetTheme(current *sheets.SpreadsheetTheme) *sheets.SpreadsheetTheme {
+	theme := &sheets.SpreadsheetTheme{}
+	if current != nil {
+		theme.PrimaryFontFamily = current.PrimaryFontFamily
+	}
+	if !m.PrimaryFontFamily.IsNull() {
+		theme.PrimaryFontFamily = m.PrimaryFontFamily.ValueString()
+	}
+
+	colors := map[string]*sheets.ColorStyle{}
+	if current != nil {
+		for _, pair := range current.ThemeColors {
+			colors[pair.ColorType] = pair.Color
+		}
+	}
+	for colorType, color := range m.ThemeColors {
+		colors[colorType] = &sheets.ColorStyle{RgbColor: ColorFromValue(color)}
+	}
+
+	colorTypes := make([]string, 0, len(colors))
+	for colorType := range colors {
+		colorTypes = append(colorTypes, colorType)
+	}
+	sort.Strings(colorTypes)
+	for _, colorType := range colorTypes {
+		theme.ThemeColors = append(theme.ThemeColors, &sheets.ThemeColorPair{ColorType: colorType, Color: colors[colorType]})
+	}
+	return theme
+}
+
+// Refresh updates the managed attributes with the theme returned by the API.
+func (m *SpreadsheetThemeModel) Refresh(theme *sheets.SpreadsheetTheme) {
+	if theme == nil {
+		theme = &sheets.SpreadsheetTheme{}
+	}
+	m.PrimaryFontFamily = refreshManagedString(m.PrimaryFontFamily, theme.PrimaryFontFamily)
+	for colorType, current := range m.ThemeColors {
+		var color *sheets.Color
+		for _, pair := range theme.ThemeColors {
+			if pair.ColorType == colorType && pair.Color != nil {
+				color = pair.Color.RgbColor
+			}
+		}
+		if color == nil {
+			m.ThemeColors[colorType] = types.StringValue("")
+			continue
+		}
+		m.ThemeColors[colorType] = RefreshColor(current, color)
+	}
+}
+
+// ToSpreadsheetProperties converts the managed attributes into the API representation.
+// The current properties are needed to keep the theme colors that are not managed.
+func (m SpreadsheetPropertiesResourceModel) ToSpreadsheetProperties(current *sheets.SpreadsheetProperties) *sheets.SpreadsheetProperties {
+	if current == nil {
+		current = &sheets.SpreadsheetProperties{}
+	}
+	properties := &sheets.SpreadsheetProperties{
+		Title:      m.Title.ValueString(),
+		Locale:     m.Locale.ValueString(),
+		TimeZone:   m.TimeZone.ValueString(),
+		AutoRecalc: m.AutoRecalc.ValueString(),
+	}
+	if m.IterativeCalculationSettings != nil {
+		properties.IterativeCalculationSettings = &sheets.IterativeCalculationSettings{
+			MaxIterations:        m.IterativeCalculationSettings.MaxIterations.ValueInt64(),
+			ConvergenceThreshold: m.IterativeCalculationSettings.ConvergenceThreshold.ValueFloat64(),
+		}
+	}
+	if m.DefaultFormat != nil {
+		properties.DefaultFormat = m.DefaultFormat.ToCellFormat()
+	}
+	if m.SpreadsheetTheme != nil {
+		properties.SpreadsheetTheme = m.SpreadsheetTheme.ToSpreadsheetTheme(current.SpreadsheetTheme)
+	}
+	return properties
+}
+
+// Fields returns the field mask of the attributes that are set.
+func (m SpreadsheetPropertiesResourceModel) Fields() []string {
+	var fields []string
+	add := func(isNull bool, field string) {
+		if !isNull {
+			fields = append(fields, field)
+		}
+	}
+	add(m.Title.IsNull(), "title")
+	add(m.Locale.IsNull(), "locale")
+	add(m.TimeZone.IsNull(), "timeZone")
+	add(m.AutoRecalc.IsNull(), "autoRecalc")
+	add(m.IterativeCalculationSettings == nil, "iterativeCalculationSettings")
+	fields = append(fields, m.DefaultFormat.Fields("defaultFormat")...)
+	add(m.SpreadsheetTheme == nil, "spreadsheetTheme")
+	return fields
+}
+
+// Refresh updates the managed attributes with the properties returned by the API.
+func (m *SpreadsheetPropertiesResourceModel) Refresh(properties *sheets.SpreadsheetProperties) {
+	if properties == nil {
+		properties = &sheets.SpreadsheetProperties{}
+	}
+	m.Title = refreshManagedString(m.Title, properties.Title)
+	m.Locale = refreshManagedString(m.Locale, properties.Locale)
+	m.TimeZone = refreshManagedString(m.TimeZone, properties.TimeZone)
+	m.AutoRecalc = refreshManagedString(m.AutoRecalc, properties.AutoRecalc)
+
+	if m.IterativeCalculationSettings != nil {
+		// Iterative calculation is disabled when the settings are missing.
+		if properties.IterativeCalculationSettings == nil {
+			m.IterativeCalculationSettings = nil
+		} else {
+			settings := properties.IterativeCalculationSettings
+			if !m.IterativeCalculationSettings.MaxIterations.IsNull() {
+				m.IterativeCalculationSettings.MaxIterations = types.Int64Value(settings.MaxIterations)
+			}
+			if !m.IterativeCalculationSettings.ConvergenceThreshold.IsNull() {
+				m.IterativeCalculationSettings.ConvergenceThreshold = types.Float64Value(settings.ConvergenceThreshold)
+			}
+		}
+	}
+
+	if m.DefaultFormat != nil {
+		format := properties.DefaultFormat
+		if format == nil {
+			format = &sheets.CellFormat{}
+		}
+		m.DefaultFormat.Refresh([]*sheets.CellFormat{format})
+	}
+
+	if m.SpreadsheetTheme != nil {
+		m.SpreadsheetTheme.Refresh(properties.SpreadsheetTheme)
+	}
+}
+
+// refreshManagedString returns the value returned by the API. Null values are not managed and are never refreshed.
+func refreshManagedString(current types.String, value string) types.String {
+	if current.IsNull() {
+		return current
+	}
+	return types.StringValue(value)
+}
+
+func (r *SpreadsheetPropertiesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_spreadsheet_properties"
+}
+
+func (r *SpreadsheetPropertiesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Sets the properties of an existing spreadsheet, such as its locale or time zone.
+
+Only the attributes that are set are managed. Removing an attribute, or the resource, leaves the current value of the spreadsheet.`,
+
+		Attributes: map[string]schema.Attribute{
+			"spreadsheet_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID for the spreadsheet. It can be obtained from the URL.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "The title of the spreadsheet",
+				Optional:            true,
+			},
+			"locale": schema.StringAttribute{
+				MarkdownDescription: "The locale of the spreadsheet, such as `en_GB` or `es_ES`. It sets how dates, numbers and currencies are displayed",
+				Optional:            true,
+			},
+			"time_zone": schema.StringAttribute{
+				MarkdownDescription: "The time zone of the spreadsheet in CLDR format, such as `Europe/Madrid`",
+				Optional:            true,
+			},
+			"auto_recalc": schema.StringAttribute{
+				MarkdownDescription: "How often volatile functions, like `NOW`, are recalculated. One of `ON_CHANGE`, `MINUTE` or `HOUR`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("ON_CHANGE", "MINUTE", "HOUR"),
+				},
+			},
+			"iterative_calculation_settings": schema.SingleNestedAttribute{
+				MarkdownDescription: "Enables iterative calculation, so formulas with circular references are resolved",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"max_iterations": schema.Int64Attribute{
+						MarkdownDescription: "The maximum number of calculation rounds",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"convergence_threshold": schema.Float64Attribute{
+						MarkdownDescription: "The calculation stops when the results change less than this value between rounds",
+						Optional:            true,
+						Validators: []validator.Float64{
+							float64validator.AtLeast(0),
+						},
+					},
+				},
+			},
+			"default_format": schema.SingleNestedAttribute{
+				MarkdownDescription: "The format of the cells that don't have one",
+				Optional:            true,
+				Attributes:          cellFormatAttributes(),
+			},
+			"spreadsheet_theme": schema.SingleNestedAttribute{
+				MarkdownDescription: "The theme of the spreadsheet, used by charts, tables and cells that use theme colors",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"primary_font_family": schema.StringAttribute{
+						MarkdownDescription: "The font family of the theme, such as `Roboto`",
+						Optional:            true,
+					},
+					"theme_colors": schema.MapAttribute{
+						MarkdownDescription: "The theme colors in #RRGGBB notation by type. The types are `TEXT`, `BACKGROUND`, `ACCENT1` to `ACCENT6` and `LINK`. Colors that are not set are left untouched",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Validators: []validator.Map{
+							mapvalidator.KeysAre(stringvalidator.OneOf(ThemeColorTypes...)),
+							mapvalidator.ValueStringsAre(hexColorValidator()),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure implements resource.ResourceWithConfigure.
+func (r *SpreadsheetPropertiesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sheets.Service)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Type",
+			fmt.Sprintf("Expected *sheets.Service, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create is called when the provider must create a new resource. Config
+// and planned state values should be read from the
+// CreateRequest and new state values set on the CreateResponse.
+func (r *SpreadsheetPropertiesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SpreadsheetPropertiesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.update(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to update spreadsheet properties", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read is called when the provider must read resource values in order
+// to update state. Planned state values should be read from the
+// ReadRequest and new state values set on the ReadResponse.
+func (r *SpreadsheetPropertiesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SpreadsheetPropertiesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getRequest := r.client.Spreadsheets.Get(data.SpreadsheetID.ValueString())
+	getRequest.Fields("spreadsheetId,properties")
+	getRequest.Context(ctx)
+	spreadsheet, err := getRequest.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read data,", err.Error())
+		return
+	}
+
+	data.Refresh(spreadsheet.Properties)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is called to update the state of the resource. Config, planned
+// state, and prior state values should be read from the
+// UpdateRequest and new state values set on the UpdateResponse.
+func (r *SpreadsheetPropertiesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SpreadsheetPropertiesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.update(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to perform update request", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete is called when the provider must delete the resource. Config
+// values may be read from the DeleteRequest.
+//
+// If execution completes without error, the framework will automatically
+// call DeleteResponse.State.RemoveResource(), so it can be omitted
+// from provider logic.
+func (r *SpreadsheetPropertiesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The spreadsheet keeps its properties, there is no previous value to restore.
+}
+
+func (r *SpreadsheetPropertiesResource) update(ctx context.Context, data SpreadsheetPropertiesResourceModel) error {
+	fields := data.Fields()
+	if len(fields) == 0 {
+		return nil
+	}
+
+	getRequest := r.client.Spreadsheets.Get(data.SpreadsheetID.ValueString())
+	getRequest.Fields("spreadsheetId,properties")
+	getRequest.Context(ctx)
+	spreadsheet, err := getRequest.Do()
+	if err != nil {
+		return err
+	}
+
+	updateRequest := r.client.Spreadsheets.BatchUpdate(data.SpreadsheetID.ValueString(), &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{UpdateSpreadsheetProperties: &sheets.UpdateSpreadsheetPropertiesRequest{
+				Properties: data.ToSpreadsheetProperties(spreadsheet.Properties),
+				Fields:     strings.Join(fields, ","),
+			}},
+		},
+	})
+	updateRequest.Context(ctx)
+	_, err = updateRequest.Do()
+	return err
+}
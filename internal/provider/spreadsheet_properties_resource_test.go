@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestAccSpreadsheetPropertiesResource(t *testing.T) {
+	properties := &sheets.SpreadsheetProperties{
+		Title:      "budget",
+		Locale:     "en_US",
+		TimeZone:   "America/New_York",
+		AutoRecalc: "ON_CHANGE",
+		SpreadsheetTheme: &sheets.SpreadsheetTheme{
+			PrimaryFontFamily: "Arial",
+			ThemeColors: []*sheets.ThemeColorPair{
+				{ColorType: "TEXT", Color: &sheets.ColorStyle{RgbColor: &sheets.Color{}}},
+				{ColorType: "ACCENT1", Color: &sheets.ColorStyle{RgbColor: &sheets.Color{Blue: 1}}},
+			},
+		},
+	}
+	var fields string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v4/spreadsheets/{spreadsheetId}", func(w http.ResponseWriter, r *http.Request) {
+		res := sheets.Spreadsheet{
+			SpreadsheetId: r.PathValue("spreadsheetId"),
+			Properties:    properties,
+		}
+		err := json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+	mux.HandleFunc("POST /v4/spreadsheets/{spreadsheetIdUpdate}", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		requestBody := &sheets.BatchUpdateSpreadsheetRequest{}
+		err := json.NewDecoder(r.Body).Decode(requestBody)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		update := requestBody.Requests[0].UpdateSpreadsheetProperties
+		if update == nil {
+			t.Errorf("Unexpected request %v", requestBody.Requests[0])
+		} else {
+			fields = update.Fields
+			for _, field := range strings.Split(update.Fields, ",") {
+				switch strings.Split(field, ".")[0] {
+				case "title":
+					properties.Title = update.Properties.Title
+				case "locale":
+					properties.Locale = update.Properties.Locale
+				case "timeZone":
+					properties.TimeZone = update.Properties.TimeZone
+				case "autoRecalc":
+					properties.AutoRecalc = update.Properties.AutoRecalc
+				case "iterativeCalculationSettings":
+					properties.IterativeCalculationSettings = update.Properties.IterativeCalculationSettings
+				case "defaultFormat":
+					properties.DefaultFormat = update.Properties.DefaultFormat
+				case "spreadsheetTheme":
+					properties.SpreadsheetTheme = update.Properties.SpreadsheetTheme
+				default:
+					t.Errorf("Unexpected field %s", field)
+				}
+			}
+		}
+
+		err = json.NewEncoder(w).Encode(sheets.BatchUpdateSpreadsheetResponse{})
+		if err != nil {
+			log.Println(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	config := fmt.Sprintf(`
+provider "gsheets" {
+	endpoint = "%s"
+}
+
+resource "gsheets_spreadsheet_properties" "test" {
+	spreadsheet_id = "test-spreadsheet-id"
+	locale = "en_GB"
+	time_zone = "Europe/Madrid"
+	auto_recalc = "MINUTE"
+	iterative_calculation_settings = {
+		max_iterations = 50
+	}
+	default_format = {
+		text_format = { font_family = "Roboto" }
+	}
+	spreadsheet_theme = {
+		primary_font_family = "Lato"
+		theme_colors = {
+			ACCENT1 = "#ff0000"
+		}
+	}
+}
+`, server.URL)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("gsheets_spreadsheet_properties.test", "title"),
+					func(s *terraform.State) error {
+						expected := "locale,timeZone,autoRecalc,iterativeCalculationSettings,defaultFormat.textFormat.fontFamily,spreadsheetTheme"
+						if fields != expected {
+							return fmt.Errorf("Expected fields %s, got %s", expected, fields)
+						}
+						if properties.Title != "budget" || properties.Locale != "en_GB" || properties.IterativeCalculationSettings.MaxIterations != 50 {
+							return fmt.Errorf("Unexpected properties %v", properties)
+						}
+						// Every theme color must be sent, the ones that are not managed are kept.
+						theme := properties.SpreadsheetTheme
+						if theme.PrimaryFontFamily != "Lato" || len(theme.ThemeColors) != 2 || theme.ThemeColors[0].ColorType != "ACCENT1" || theme.ThemeColors[0].Color.RgbColor.Red != 1 {
+							return fmt.Errorf("Unexpected theme %v", theme.ThemeColors)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+			{
+				// Someone changed the locale from the UI, it is set again.
+				PreConfig: func() {
+					properties.Locale = "en_US"
+					properties.SpreadsheetTheme.ThemeColors[0].Color.RgbColor = &sheets.Color{Green: 1}
+				},
+				Config: config,
+				Check: func(s *terraform.State) error {
+					if properties.Locale != "en_GB" || properties.SpreadsheetTheme.ThemeColors[0].Color.RgbColor.Red != 1 {
+						return fmt.Errorf("Expected the properties to be set again, got %v", properties)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
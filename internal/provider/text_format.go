@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/api/sheets/v4"
+)
+
+// TextFormatModel is the text format of a cell. Null attributes are not managed.
+type TextFormatModel struct {
+	Bold            types.Bool   `tfsdk:"bold"`
+	Italic          types.Bool   `tfsdk:"italic"`
+	Strikethrough   types.Bool   `tfsdk:"strikethrough"`
+	Underline       types.Bool   `tfsdk:"underline"`
+	FontFamily      types.String `tfsdk:"font_family"`
+	FontSize        types.Int64  `tfsdk:"font_size"`
+	ForegroundColor types.String `tfsdk:"foreground_color"`
+}
+
+// ToTextFormat converts the model into the API representation. It returns nil when there is no model.
+func (m *TextFormatModel) ToTextFormat() *sheets.TextFormat {
+	if m == nil {
+		return nil
+	}
+	return &sheets.TextFormat{
+		Bold:            m.Bold.ValueBool(),
+		Italic:          m.Italic.ValueBool(),
+		Strikethrough:   m.Strikethrough.ValueBool(),
+		Underline:       m.Underline.ValueBool(),
+		FontFamily:      m.FontFamily.ValueString(),
+		FontSize:        m.FontSize.ValueInt64(),
+		ForegroundColor: ColorFromValue(m.ForegroundColor),
+	}
+}
+
+// Fields returns the field mask of the attributes that are set, prefixed by the path of the text format.
+func (m *TextFormatModel) Fields(prefix string) []string {
+	if m == nil {
+		return nil
+	}
+	var fields []string
+	add := func(isNull bool, field string) {
+		if !isNull {
+			fields = append(fields, prefix+"."+field)
+		}
+	}
+	add(m.Bold.IsNull(), "bold")
+	add(m.Italic.IsNull(), "italic")
+	add(m.Strikethrough.IsNull(), "strikethrough")
+	add(m.Underline.IsNull(), "underline")
+	add(m.FontFamily.IsNull(), "fontFamily")
+	add(m.FontSize.IsNull(), "fontSize")
+	add(m.ForegroundColor.IsNull(), "foregroundColor")
+	return fields
+}
+
+// NewTextFormatModel converts the API representation into a model where the default values are null.
+// It returns nil when nothing is set.
+func NewTextFormatModel(format *sheets.TextFormat) *TextFormatModel {
+	if format == nil {
+		return nil
+	}
+	m := &TextFormatModel{
+		Bold:            RefreshBool(types.BoolNull(), format.Bold),
+		Italic:          RefreshBool(types.BoolNull(), format.Italic),
+		Strikethrough:   RefreshBool(types.BoolNull(), format.Strikethrough),
+		Underline:       RefreshBool(types.BoolNull(), format.Underline),
+		FontFamily:      RefreshString(types.StringNull(), format.FontFamily),
+		FontSize:        RefreshInt64(types.Int64Null(), format.FontSize),
+		ForegroundColor: RefreshColor(types.StringNull(), format.ForegroundColor),
+	}
+	if len(m.Fields("textFormat")) == 0 {
+		return nil
+	}
+	return m
+}
+
+// TextFormatRunModel formats the text of a cell from a character until the next run.
+type TextFormatRunModel struct {
+	StartIndex types.Int64      `tfsdk:"start_index"`
+	Format     *TextFormatModel `tfsdk:"format"`
+	Link       types.String     `tfsdk:"link"`
+}
+
+// ToTextFormatRuns converts the runs into the API representation.
+func ToTextFormatRuns(runs []TextFormatRunModel) []*sheets.TextFormatRun {
+	result := make([]*sheets.TextFormatRun, 0, len(runs))
+	for _, run := range runs {
+		format := run.Format.ToTextFormat()
+		if !run.Link.IsNull() {
+			if format == nil {
+				format = &sheets.TextFormat{}
+			}
+			format.Link = &sheets.Link{Uri: run.Link.ValueString()}
+		}
+		result = append(result, &sheets.TextFormatRun{
+			StartIndex: run.StartIndex.ValueInt64(),
+			Format:     format,
+		})
+	}
+	return result
+}
+
+// NewTextFormatRunModels is the inverse of ToTextFormatRuns.
+func NewTextFormatRunModels(runs []*sheets.TextFormatRun) []TextFormatRunModel {
+	result := make([]TextFormatRunModel, 0, len(runs))
+	for _, run := range runs {
+		m := TextFormatRunModel{
+			StartIndex: types.Int64Value(run.StartIndex),
+			Format:     NewTextFormatModel(run.Format),
+			Link:       types.StringNull(),
+		}
+		if run.Format != nil && run.Format.Link != nil {
+			m.Link = RefreshString(types.StringNull(), run.Format.Link.Uri)
+		}
+		result = append(result, m)
+	}
+	return result
+}
+
+// EqualTextFormatRuns compares the runs the way google sheets stores them, so colors that only differ in case are the same.
+func EqualTextFormatRuns(a, b []TextFormatRunModel) bool {
+	normalize := func(runs []TextFormatRunModel) []*sheets.TextFormatRun {
+		result := ToTextFormatRuns(NewTextFormatRunModels(ToTextFormatRuns(runs)))
+		// A first run without format is the same as no run, google sheets may return it either way.
+		if len(result) > 0 && result[0].StartIndex == 0 && result[0].Format == nil {
+			result = result[1:]
+		}
+		return result
+	}
+	return jsonEqual(normalize(a), normalize(b))
+}
+
+// textFormatAttribute is shared by all the resources that format text.
+func textFormatAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"bold": schema.BoolAttribute{
+				Optional: true,
+			},
+			"italic": schema.BoolAttribute{
+				Optional: true,
+			},
+			"strikethrough": schema.BoolAttribute{
+				Optional: true,
+			},
+			"underline": schema.BoolAttribute{
+				Optional: true,
+			},
+			"font_family": schema.StringAttribute{
+				MarkdownDescription: "The font family, such as `Roboto`",
+				Optional:            true,
+			},
+			"font_size": schema.Int64Attribute{
+				MarkdownDescription: "The font size in points",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"foreground_color": schema.StringAttribute{
+				MarkdownDescription: "The text color in #RRGGBB notation",
+				Optional:            true,
+				Validators: []validator.String{
+					hexColorValidator(),
+				},
+			},
+		},
+	}
+}
+
+// textFormatRunsAttribute is shared by all the resources that format parts of the text of a cell.
+func textFormatRunsAttribute(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"start_index": schema.Int64Attribute{
+					MarkdownDescription: "The character where the run starts, counting from 0",
+					Required:            true,
+					Validators: []validator.Int64{
+						int64validator.AtLeast(0),
+					},
+				},
+				"format": textFormatAttribute("The format of the text of the run. Attributes that are not set use the format of the cell"),
+				"link": schema.StringAttribute{
+					MarkdownDescription: "The URL the text of the run links to",
+					Optional:            true,
+				},
+			},
+		},
+	}
+}
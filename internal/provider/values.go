@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// StringsFromValues converts a list of terraform strings into go strings.
+func StringsFromValues(values []types.String) []string {
+	if values == nil {
+		return nil
+	}
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		result = append(result, value.ValueString())
+	}
+	return result
+}
+
+// RefreshString returns the value returned by the API.
+// Google sheets omits empty values, so they are kept null if they were not set.
+func RefreshString(current types.String, value string) types.String {
+	if current.IsNull() && value == "" {
+		return current
+	}
+	return types.StringValue(value)
+}
+
+// RefreshBool returns the value returned by the API.
+// Google sheets omits false values, so they are kept null if they were not set.
+func RefreshBool(current types.Bool, value bool) types.Bool {
+	if current.IsNull() && !value {
+		return current
+	}
+	return types.BoolValue(value)
+}
+
+// RefreshInt64 returns the value returned by the API.
+// Google sheets omits zero values, so they are kept null if they were not set.
+func RefreshInt64(current types.Int64, value int64) types.Int64 {
+	if current.IsNull() && value == 0 {
+		return current
+	}
+	return types.Int64Value(value)
+}